@@ -33,6 +33,22 @@ func setAuthCookies(c *gin.Context, env *bootstrap.Env, accessToken string, refr
 	}
 }
 
+func setRespondentCookie(c *gin.Context, env *bootstrap.Env, respondentToken string) {
+	if respondentToken == "" {
+		return
+	}
+
+	sameSite := resolveSameSite(env.CookieSameSite)
+	c.SetSameSite(sameSite)
+
+	secure := env.CookieSecure
+	if sameSite == http.SameSiteNoneMode && !secure {
+		secure = true
+	}
+
+	c.SetCookie(domain.RespondentCookieName, respondentToken, hoursToSeconds(env.RespondentTokenExpiryHour), "/", env.CookieDomain, secure, true)
+}
+
 func resolveSameSite(mode string) http.SameSite {
 	switch strings.ToLower(mode) {
 	case "strict":
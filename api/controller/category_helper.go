@@ -27,3 +27,14 @@ func normalizeCategories(values []string) []string {
 
 	return categories
 }
+
+// normalizeLabels trims every label and drops the blank ones, keeping the original order.
+func normalizeLabels(values []string) []string {
+	labels := make([]string, 0, len(values))
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			labels = append(labels, trimmed)
+		}
+	}
+	return labels
+}
@@ -0,0 +1,191 @@
+package controller
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/gin-gonic/gin"
+)
+
+type MediaController struct {
+	MediaUsecase domain.MediaUsecase
+}
+
+// Upload attaches an image to a poll option.
+// @Summary Upload option media
+// @Description Attach an image (PNG, JPEG, GIF or WebP, up to 5 MB) to one option of a poll (super admin or sheet owner). An existing attachment on the option is replaced.
+// @Tags Polls (Admin)
+// @Accept mpfd
+// @Produce json
+// @Security BearerAuth
+// @Param poll_id formData string true "Poll identifier"
+// @Param option formData int true "Zero-based option index"
+// @Param file formData file true "Image file"
+// @Success 200 {object} domain.PollAdminResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 409 {object} domain.ErrorResponse
+// @Failure 413 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/admin/media/upload [post]
+func (mc *MediaController) Upload(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	pollID := c.PostForm("poll_id")
+	if pollID == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "poll id is required"})
+		return
+	}
+
+	option, err := strconv.Atoi(c.PostForm("option"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "option must be an option index"})
+		return
+	}
+
+	if !mc.authorizePoll(c, pollID) {
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "file is required"})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+	defer file.Close()
+
+	poll, err := mc.MediaUsecase.UploadOptionMedia(c, pollID, option, domain.MediaUpload{
+		FileName: header.Filename,
+		Size:     header.Size,
+		Content:  file,
+	})
+	if err != nil {
+		c.JSON(mediaErrorStatus(err), domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapPollToAdminResponse(poll))
+}
+
+// Remove detaches the image from a poll option.
+// @Summary Remove option media
+// @Description Remove the image attached to one option of a poll (super admin or sheet owner).
+// @Tags Polls (Admin)
+// @Produce json
+// @Security BearerAuth
+// @Param poll_id query string true "Poll identifier"
+// @Param option query int true "Zero-based option index"
+// @Success 200 {object} domain.PollAdminResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/admin/media/delete [put]
+func (mc *MediaController) Remove(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	pollID := c.Query("poll_id")
+	if pollID == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "poll id is required"})
+		return
+	}
+
+	option, err := strconv.Atoi(c.Query("option"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "option must be an option index"})
+		return
+	}
+
+	if !mc.authorizePoll(c, pollID) {
+		return
+	}
+
+	poll, err := mc.MediaUsecase.RemoveOptionMedia(c, pollID, option)
+	if err != nil {
+		c.JSON(mediaErrorStatus(err), domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapPollToAdminResponse(poll))
+}
+
+// Download serves an option attachment.
+// @Summary Download option media
+// @Description Download an image attached to a poll option. Attachment keys are listed in poll responses.
+// @Tags Polls
+// @Produce octet-stream
+// @Param key path string true "Attachment key"
+// @Success 200 {file} file
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/media/{key} [get]
+func (mc *MediaController) Download(c *gin.Context) {
+	key := c.Param("key")
+
+	content, err := mc.MediaUsecase.Open(c, key)
+	if err != nil {
+		c.JSON(mediaErrorStatus(err), domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+	defer content.Close()
+
+	contentType := mime.TypeByExtension(filepath.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.DataFromReader(http.StatusOK, -1, contentType, content, nil)
+}
+
+// authorizePoll checks that the caller owns the poll's sheet or is a super admin and
+// writes the error response when not.
+func (mc *MediaController) authorizePoll(c *gin.Context, pollID string) bool {
+	sheet, err := mc.MediaUsecase.GetPollSheet(c, pollID)
+	if err != nil {
+		c.JSON(mediaErrorStatus(err), domain.ErrorResponse{Message: err.Error()})
+		return false
+	}
+
+	userID := c.GetString("x-user-id")
+	userType := domain.UserType(c.GetString("x-user-type"))
+
+	if userID == "" || (userType != domain.SuperAdmin && sheet.UserID.Hex() != userID) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return false
+	}
+
+	return true
+}
+
+func mediaErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrMediaTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, domain.ErrUnsupportedMediaType), errors.Is(err, domain.ErrInvalidMediaOption):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrMediaNotFound), errors.Is(err, domain.ErrPollNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrMediaConflict):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
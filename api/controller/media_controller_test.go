@@ -0,0 +1,67 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/api/controller"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRemoveMedia(t *testing.T) {
+	ownerID := primitive.NewObjectID()
+	pollID := primitive.NewObjectID().Hex()
+	sheet := domain.Sheet{ID: primitive.NewObjectID(), UserID: ownerID}
+
+	newRouter := func(mc *controller.MediaController, userID string) *gin.Engine {
+		router := gin.Default()
+		router.PUT("/admin/media/delete", func(c *gin.Context) {
+			c.Set("x-user-id", userID)
+			c.Set("x-user-type", string(domain.VerifiedAdmin))
+			mc.Remove(c)
+		})
+		return router
+	}
+
+	t.Run("another admin", func(t *testing.T) {
+		mockMediaUsecase := new(mocks.MediaUsecase)
+		mockMediaUsecase.On("GetPollSheet", mock.Anything, pollID).Return(sheet, nil)
+
+		rec := httptest.NewRecorder()
+		newRouter(&controller.MediaController{MediaUsecase: mockMediaUsecase}, primitive.NewObjectID().Hex()).
+			ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/admin/media/delete?poll_id="+pollID+"&option=0", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		mockMediaUsecase.AssertNotCalled(t, "RemoveOptionMedia", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		mockMediaUsecase := new(mocks.MediaUsecase)
+		mockMediaUsecase.On("GetPollSheet", mock.Anything, pollID).Return(sheet, nil)
+		mockMediaUsecase.On("RemoveOptionMedia", mock.Anything, pollID, 0).Return(domain.Poll{}, nil)
+
+		rec := httptest.NewRecorder()
+		newRouter(&controller.MediaController{MediaUsecase: mockMediaUsecase}, ownerID.Hex()).
+			ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/admin/media/delete?poll_id="+pollID+"&option=0", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockMediaUsecase.AssertExpectations(t)
+	})
+
+	t.Run("poll not found", func(t *testing.T) {
+		mockMediaUsecase := new(mocks.MediaUsecase)
+		mockMediaUsecase.On("GetPollSheet", mock.Anything, pollID).Return(domain.Sheet{}, domain.ErrPollNotFound)
+
+		rec := httptest.NewRecorder()
+		newRouter(&controller.MediaController{MediaUsecase: mockMediaUsecase}, ownerID.Hex()).
+			ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/admin/media/delete?poll_id="+pollID+"&option=0", nil))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
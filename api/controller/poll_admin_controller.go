@@ -1,9 +1,11 @@
 package controller
 
 import (
+	"errors"
 	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"net/http"
 	"time"
 )
@@ -21,10 +23,26 @@ type PollAdminController struct {
 // @Security BearerAuth
 // @Param sheet_id formData string true "Sheet identifier"
 // @Param title formData string true "Poll title"
-// @Param options formData []string true "Poll options"
+// @Param options formData []string true "Poll options (matrix columns for matrix polls)"
+// @Param rows formData []string false "Matrix row labels (matrix polls)"
 // @Param poll_type formData string true "Poll type"
 // @Param category formData []string true "Poll categories (repeat parameter for multiple values)"
 // @Param description formData string false "Poll description"
+// @Param scale_min formData int false "Rating scale minimum (rating polls, default 1)"
+// @Param scale_max formData int false "Rating scale maximum (rating polls, default 5)"
+// @Param scale_step formData int false "Rating scale step (rating polls, default 1)"
+// @Param scale_labels formData []string false "Label for each scale point (rating and nps polls)"
+// @Param slide_min formData number false "Slide minimum (slide polls, default 0)"
+// @Param slide_max formData number false "Slide maximum (slide polls, default 100)"
+// @Param slide_step formData number false "Slide step (slide polls, default 1)"
+// @Param slide_unit formData string false "Unit shown next to slide values"
+// @Param correct_options formData []int false "Indices of the correct options (quiz questions)"
+// @Param points formData int false "Points for a correct answer (quiz questions, default 1)"
+// @Param required formData bool false "Whether the poll must be answered when submitting the sheet"
+// @Param shuffle_options formData bool false "Show the options in a per-respondent random order (single choice, multi choice, ranking and matrix polls)"
+// @Param allow_other formData bool false "Append an \"Other\" option that requires a text answer (single and multi choice polls)"
+// @Param min_selections formData int false "Minimum number of selected options (multi choice polls)"
+// @Param max_selections formData int false "Maximum number of selected options (multi choice polls)"
 // @Success 201 {object} domain.SuccessResponse
 // @Failure 400 {object} domain.ErrorResponse
 // @Failure 401 {object} domain.ErrorResponse
@@ -39,12 +57,6 @@ func (pc *PollAdminController) Create(c *gin.Context) {
 		return
 	}
 
-	categories := normalizeCategories(req.Category)
-	if len(categories) == 0 {
-		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "at least one category is required"})
-		return
-	}
-
 	hexSheetID, err := primitive.ObjectIDFromHex(req.SheetID)
 
 	if err != nil {
@@ -52,30 +64,16 @@ func (pc *PollAdminController) Create(c *gin.Context) {
 		return
 	}
 
-	var poll domain.Poll
-
-	votes := make([]int, req.PollType.VoteSlots(len(req.Options)))
-	if req.PollType == domain.PollTypeOpinion {
-		votes = nil
-	}
-
-	poll = domain.Poll{
-		ID:          primitive.NewObjectID(),
-		SheetID:     hexSheetID,
-		Title:       req.Title,
-		Options:     req.Options,
-		PollType:    req.PollType,
-		Category:    categories,
-		Participant: 0,
-		Votes:       votes,
-		Description: req.Description,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+	poll, err := buildSheetPoll(req.SheetPoll(), 0, "poll")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+		return
 	}
 
-	if req.PollType == domain.PollTypeOpinion {
-		poll.Responses = []string{}
-	}
+	poll.SheetID = hexSheetID
+	poll.CreatedAt = time.Now()
+	poll.UpdatedAt = poll.CreatedAt
+	poll.ResetAggregates()
 
 	err = pc.PollAdminUsecase.CreatePoll(c, &poll)
 	if err != nil {
@@ -96,13 +94,31 @@ func (pc *PollAdminController) Create(c *gin.Context) {
 // @Param id query string true "Poll identifier"
 // @Param sheet_id formData string true "Sheet identifier"
 // @Param title formData string true "Poll title"
-// @Param options formData []string true "Poll options"
+// @Param options formData []string true "Poll options (matrix columns for matrix polls); the \"Other\" entry of a poll that allows it may be sent back as listed"
+// @Param rows formData []string false "Matrix row labels (matrix polls)"
 // @Param poll_type formData string true "Poll type"
 // @Param category formData []string true "Poll categories (repeat parameter for multiple values)"
 // @Param description formData string false "Poll description"
+// @Param scale_min formData int false "Rating scale minimum (rating polls, default 1)"
+// @Param scale_max formData int false "Rating scale maximum (rating polls, default 5)"
+// @Param scale_step formData int false "Rating scale step (rating polls, default 1)"
+// @Param scale_labels formData []string false "Label for each scale point (rating and nps polls)"
+// @Param slide_min formData number false "Slide minimum (slide polls, default 0)"
+// @Param slide_max formData number false "Slide maximum (slide polls, default 100)"
+// @Param slide_step formData number false "Slide step (slide polls, default 1)"
+// @Param slide_unit formData string false "Unit shown next to slide values"
+// @Param correct_options formData []int false "Indices of the correct options (quiz questions)"
+// @Param points formData int false "Points for a correct answer (quiz questions, default 1)"
+// @Param required formData bool false "Whether the poll must be answered when submitting the sheet"
+// @Param shuffle_options formData bool false "Show the options in a per-respondent random order (single choice, multi choice, ranking and matrix polls)"
+// @Param allow_other formData bool false "Append an \"Other\" option that requires a text answer (single and multi choice polls)"
+// @Param min_selections formData int false "Minimum number of selected options (multi choice polls)"
+// @Param max_selections formData int false "Maximum number of selected options (multi choice polls)"
 // @Success 200 {object} domain.SuccessResponse
 // @Failure 400 {object} domain.ErrorResponse
 // @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 409 {object} domain.ErrorResponse
 // @Failure 500 {object} domain.ErrorResponse
 // @Router /api/v1/edit [post]
 func (pc *PollAdminController) Edit(c *gin.Context) {
@@ -116,12 +132,6 @@ func (pc *PollAdminController) Edit(c *gin.Context) {
 		return
 	}
 
-	categories := normalizeCategories(req.Category)
-	if len(categories) == 0 {
-		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "at least one category is required"})
-		return
-	}
-
 	hexSheetID, err := primitive.ObjectIDFromHex(req.SheetID)
 
 	if err != nil {
@@ -136,33 +146,44 @@ func (pc *PollAdminController) Edit(c *gin.Context) {
 		return
 	}
 
-	var poll domain.Poll
-
-	votes := make([]int, req.PollType.VoteSlots(len(req.Options)))
-	if req.PollType == domain.PollTypeOpinion {
-		votes = nil
+	existing, err := pc.PollAdminUsecase.GetByID(c, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Message: "poll not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		return
 	}
 
-	poll = domain.Poll{
-		ID:          UID,
-		SheetID:     hexSheetID,
-		Title:       req.Title,
-		Options:     req.Options,
-		PollType:    req.PollType,
-		Category:    categories,
-		Participant: 0,
-		Votes:       votes,
-		Description: req.Description,
-		UpdatedAt:   time.Now(),
+	// The options come back as listed, including the "Other" entry appended when the
+	// poll allowed it; drop it so that it is appended again only if still allowed.
+	pollReq := req.SheetPoll()
+	if existing.AllowOther {
+		pollReq.Options = domain.WithoutOtherOption(pollReq.Options)
 	}
 
-	if req.PollType == domain.PollTypeOpinion {
-		poll.Responses = []string{}
+	poll, err := buildSheetPoll(pollReq, 0, "poll")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+		return
 	}
 
+	poll.ID = UID
+	poll.SheetID = hexSheetID
+	poll.UpdatedAt = time.Now()
+
 	err = pc.PollAdminUsecase.EditPoll(c, &poll)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		switch {
+		case errors.Is(err, domain.ErrPollHasResponses), errors.Is(err, domain.ErrPollEditConflict):
+			c.JSON(http.StatusConflict, domain.ErrorResponse{Message: err.Error()})
+		case errors.Is(err, mongo.ErrNoDocuments):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Message: "poll not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		}
+		return
 	}
 
 	c.JSON(http.StatusOK, domain.SuccessResponse{Message: "poll updated successfully"})
@@ -170,7 +191,7 @@ func (pc *PollAdminController) Edit(c *gin.Context) {
 
 // GetBySheetID lists polls registered for a sheet.
 // @Summary List polls for sheet
-// @Description Retrieve all polls created for a sheet.
+// @Description Retrieve all polls created for a sheet. Sheets with graded quiz questions also return a leaderboard.
 // @Tags Polls (Admin)
 // @Produce json
 // @Security BearerAuth
@@ -202,18 +223,10 @@ func (pc *PollAdminController) GetBySheetID(c *gin.Context) {
 
 	var responseItems []domain.PollAdminResponse
 
+	graded := false
 	for _, poll := range polls {
-		responseItems = append(responseItems, domain.PollAdminResponse{
-			ID:          poll.ID.Hex(),
-			Title:       poll.Title,
-			Options:     poll.Options,
-			PollType:    poll.PollType,
-			Category:    poll.Category,
-			Participant: poll.Participant,
-			Votes:       poll.Votes,
-			Responses:   poll.Responses,
-			Description: poll.Description,
-		})
+		responseItems = append(responseItems, mapPollToAdminResponse(poll))
+		graded = graded || poll.IsGradable()
 	}
 
 	response := domain.PollAdminListResponse{
@@ -221,18 +234,27 @@ func (pc *PollAdminController) GetBySheetID(c *gin.Context) {
 		Pagination: domain.NewPaginationResult(pagination, total),
 	}
 
+	if graded {
+		response.Leaderboard, err = pc.PollAdminUsecase.GetLeaderboard(c, id)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+			return
+		}
+	}
+
 	c.JSON(http.StatusOK, response)
 }
 
-// Delete removes a poll.
+// Delete moves a poll to the trash.
 // @Summary Delete poll
-// @Description Delete a poll by identifier.
+// @Description Move a poll to the trash. It can be restored until the retention period ends, after which it is deleted permanently together with its ballots.
 // @Tags Polls (Admin)
 // @Produce json
 // @Security BearerAuth
 // @Param id query string true "Poll identifier"
 // @Success 200 {object} domain.SuccessResponse
 // @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
 // @Failure 500 {object} domain.ErrorResponse
 // @Router /api/v1/delete [put]
 func (pc *PollAdminController) Delete(c *gin.Context) {
@@ -246,13 +268,431 @@ func (pc *PollAdminController) Delete(c *gin.Context) {
 		return
 	}
 
-	err := pc.PollAdminUsecase.Delete(c, id)
+	deletedBy, _ := primitive.ObjectIDFromHex(c.GetString("x-user-id"))
+
+	err := pc.PollAdminUsecase.Delete(c, id, deletedBy, time.Now())
 
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		status := http.StatusInternalServerError
+		if errors.Is(err, domain.ErrPollNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusOK, domain.SuccessResponse{Message: "poll deleted successfully"})
 
 }
+
+// Trash lists the deleted polls of a sheet that can still be restored.
+// @Summary List trashed polls
+// @Description Retrieve the trashed polls of a sheet (super admin or sheet owner).
+// @Tags Polls (Admin)
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Sheet identifier"
+// @Success 200 {object} domain.PollTrashResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/admin/trash [get]
+func (pc *PollAdminController) Trash(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	id := c.Query("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "sheet id is required"})
+		return
+	}
+
+	if !pc.authorizeSheet(c, id) {
+		return
+	}
+
+	polls, err := pc.PollAdminUsecase.GetTrash(c, id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	responseItems := make([]domain.PollAdminResponse, 0, len(polls))
+	for _, poll := range polls {
+		responseItems = append(responseItems, mapPollToAdminResponse(poll))
+	}
+
+	c.JSON(http.StatusOK, domain.PollTrashResponse{Data: responseItems})
+}
+
+// Restore takes a poll out of the trash.
+// @Summary Restore poll
+// @Description Restore a trashed poll (super admin or sheet owner). Polls of a trashed sheet can only be restored together with the sheet.
+// @Tags Polls (Admin)
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Poll identifier"
+// @Success 200 {object} domain.PollAdminResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 409 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/admin/restore [put]
+func (pc *PollAdminController) Restore(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	id := c.Query("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "poll id is required"})
+		return
+	}
+
+	trashed, err := pc.PollAdminUsecase.GetTrashedByID(c, id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, domain.ErrPollNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if !pc.authorizeSheet(c, trashed.SheetID.Hex()) {
+		return
+	}
+
+	poll, err := pc.PollAdminUsecase.Restore(c, id, time.Now())
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domain.ErrPollNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, domain.ErrSheetInTrash):
+			status = http.StatusConflict
+		}
+		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapPollToAdminResponse(poll))
+}
+
+// authorizeSheet checks that the caller owns the sheet or is a super admin and writes
+// the error response when not.
+func (pc *PollAdminController) authorizeSheet(c *gin.Context, sheetID string) bool {
+	sheet, err := pc.PollAdminUsecase.GetSheet(c, sheetID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
+		return false
+	}
+
+	userID := c.GetString("x-user-id")
+	userType := domain.UserType(c.GetString("x-user-type"))
+
+	if userID == "" || (userType != domain.SuperAdmin && sheet.UserID.Hex() != userID) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return false
+	}
+
+	return true
+}
+
+// authorizePoll is authorizeSheet for the sheet of a live poll.
+func (pc *PollAdminController) authorizePoll(c *gin.Context, pollID string) bool {
+	poll, err := pc.PollAdminUsecase.GetByID(c, pollID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Message: domain.ErrPollNotFound.Error()})
+			return false
+		}
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		return false
+	}
+
+	return pc.authorizeSheet(c, poll.SheetID.Hex())
+}
+
+// GetBallots lists the individual ballots recorded for a poll.
+// @Summary List poll ballots
+// @Description Retrieve the per-respondent ballots recorded for a poll (super admin or sheet owner).
+// @Tags Polls (Admin)
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Poll identifier"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} domain.BallotListResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/admin/ballots [get]
+func (pc *PollAdminController) GetBallots(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	id := c.Query("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "poll id is required"})
+		return
+	}
+
+	if !pc.authorizePoll(c, id) {
+		return
+	}
+
+	pagination := extractPagination(c)
+
+	ballots, total, err := pc.PollAdminUsecase.GetBallots(c, id, pagination)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	responseItems := make([]domain.BallotResponse, 0, len(ballots))
+	for _, ballot := range ballots {
+		responseItems = append(responseItems, mapBallotToResponse(ballot))
+	}
+
+	response := domain.BallotListResponse{
+		Data:       responseItems,
+		Pagination: domain.NewPaginationResult(pagination, total),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteBallot drops a ballot and rebuilds the poll counters without it.
+// @Summary Delete ballot
+// @Description Remove a single ballot and recount the poll it belongs to (super admin or sheet owner).
+// @Tags Polls (Admin)
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Ballot identifier"
+// @Success 200 {object} domain.PollAdminResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/admin/ballots/delete [put]
+func (pc *PollAdminController) DeleteBallot(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	id := c.Query("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "id is required"})
+		return
+	}
+
+	ballot, err := pc.PollAdminUsecase.GetBallot(c, id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, domain.ErrBallotNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if !pc.authorizeSheet(c, ballot.SheetID.Hex()) {
+		return
+	}
+
+	poll, err := pc.PollAdminUsecase.DeleteBallot(c, id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, domain.ErrBallotNotFound) || errors.Is(err, domain.ErrPollNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapPollToAdminResponse(poll))
+}
+
+// Recount rebuilds a poll's vote counters from its ballots.
+// @Summary Recount poll
+// @Description Rebuild the participant, vote and response aggregates of a poll from its stored ballots (super admin or sheet owner).
+// @Tags Polls (Admin)
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Poll identifier"
+// @Success 200 {object} domain.PollAdminResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/admin/recount [post]
+func (pc *PollAdminController) Recount(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	id := c.Query("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "poll id is required"})
+		return
+	}
+
+	if !pc.authorizePoll(c, id) {
+		return
+	}
+
+	poll, err := pc.PollAdminUsecase.Recount(c, id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, domain.ErrPollNotFound) || errors.Is(err, mongo.ErrNoDocuments) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapPollToAdminResponse(poll))
+}
+
+// Reorder sets the display order of a sheet's polls.
+// @Summary Reorder polls
+// @Description Persist a new order for the polls of a sheet (super admin or sheet owner). The payload must list every poll of the sheet exactly once.
+// @Tags Polls (Admin)
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param payload body domain.PollReorderRequest true "New poll order"
+// @Success 200 {array} domain.PollAdminResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/admin/reorder [post]
+func (pc *PollAdminController) Reorder(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	var req domain.PollReorderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if _, err := primitive.ObjectIDFromHex(req.SheetID); err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "Invalid sheet ID"})
+		return
+	}
+
+	if !pc.authorizeSheet(c, req.SheetID) {
+		return
+	}
+
+	polls, err := pc.PollAdminUsecase.Reorder(c, req.SheetID, req.PollIDs)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, domain.ErrInvalidPollOrder) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	response := make([]domain.PollAdminResponse, 0, len(polls))
+	for _, poll := range polls {
+		response = append(response, mapPollToAdminResponse(poll))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func isAdmin(c *gin.Context) bool {
+	userType := domain.UserType(c.GetString("x-user-type"))
+	return c.GetString("x-user-id") != "" && (userType == domain.VerifiedAdmin || userType == domain.SuperAdmin)
+}
+
+func mapPollToAdminResponse(poll domain.Poll) domain.PollAdminResponse {
+	response := domain.PollAdminResponse{
+		ID:             poll.ID.Hex(),
+		Position:       poll.Position,
+		Title:          poll.Title,
+		Options:        poll.Options,
+		OptionMedia:    poll.OptionMedia,
+		PollType:       poll.PollType,
+		Required:       poll.Required,
+		ShuffleOptions: poll.ShuffleOptions,
+		Category:       poll.Category,
+		Participant:    poll.Participant,
+		Votes:          poll.Votes,
+		Rows:           poll.Rows,
+		MatrixVotes:    poll.MatrixVotes,
+		Responses:      poll.Responses,
+		Description:    poll.Description,
+		DeletedAt:      poll.DeletedAt,
+	}
+
+	if poll.AllowOther {
+		response.AllowOther = true
+		response.OtherResponses = poll.OtherResponses
+	}
+	response.MinSelections = poll.MinSelections
+	response.MaxSelections = poll.MaxSelections
+	response.ShowIf = poll.ShowIf
+
+	if poll.PollType.IsScale() {
+		response.Scale = poll.Scale
+		response.NPS = poll.NPSBreakdown()
+	}
+	if poll.PollType == domain.PollTypeSlide {
+		response.Slide = poll.Slide
+	}
+	response.Stats = poll.NumericStatistics()
+
+	if poll.IsGradable() {
+		response.CorrectOptions = poll.CorrectOptions
+		response.Points = poll.Points
+		response.CorrectRate = poll.CorrectRate()
+	}
+
+	if poll.PollType == domain.PollTypeRanking && poll.Ranking != nil {
+		response.Ranking = &domain.PollRankingResponse{
+			BordaScores:      poll.Ranking.BordaScores,
+			AveragePositions: poll.AverageRankPositions(),
+		}
+	}
+
+	return response
+}
+
+func mapBallotToResponse(ballot domain.Ballot) domain.BallotResponse {
+	return domain.BallotResponse{
+		ID:            ballot.ID.Hex(),
+		SheetID:       ballot.SheetID.Hex(),
+		PollID:        ballot.PollID.Hex(),
+		RespondentKey: ballot.RespondentKey,
+		Phone:         ballot.Phone,
+		Options:       ballot.Options,
+		Text:          ballot.Text,
+		Value:         ballot.Value,
+		Correct:       ballot.Correct,
+		Points:        ballot.Points,
+		Client:        ballot.Client,
+		CreatedAt:     ballot.CreatedAt,
+	}
+}
@@ -0,0 +1,107 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/api/controller"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestBallotOwnership(t *testing.T) {
+	ownerID := primitive.NewObjectID()
+	sheet := domain.Sheet{ID: primitive.NewObjectID(), UserID: ownerID}
+	poll := domain.Poll{ID: primitive.NewObjectID(), SheetID: sheet.ID}
+	ballot := domain.Ballot{ID: primitive.NewObjectID(), SheetID: sheet.ID, PollID: poll.ID}
+
+	newRouter := func(pc *controller.PollAdminController, userID string) *gin.Engine {
+		router := gin.Default()
+		router.Use(func(c *gin.Context) {
+			c.Set("x-user-id", userID)
+			c.Set("x-user-type", string(domain.VerifiedAdmin))
+		})
+		router.GET("/admin/ballots", pc.GetBallots)
+		router.PUT("/admin/ballots/delete", pc.DeleteBallot)
+		router.POST("/admin/recount", pc.Recount)
+		return router
+	}
+
+	newUsecase := func() *mocks.PollAdminUsecase {
+		mockPollAdminUsecase := new(mocks.PollAdminUsecase)
+		mockPollAdminUsecase.On("GetByID", mock.Anything, poll.ID.Hex()).Return(poll, nil).Maybe()
+		mockPollAdminUsecase.On("GetBallot", mock.Anything, ballot.ID.Hex()).Return(ballot, nil).Maybe()
+		mockPollAdminUsecase.On("GetSheet", mock.Anything, sheet.ID.Hex()).Return(sheet, nil)
+		return mockPollAdminUsecase
+	}
+
+	requests := []struct {
+		name   string
+		method string
+		target string
+	}{
+		{"list", http.MethodGet, "/admin/ballots?id=" + poll.ID.Hex()},
+		{"delete", http.MethodPut, "/admin/ballots/delete?id=" + ballot.ID.Hex()},
+		{"recount", http.MethodPost, "/admin/recount?id=" + poll.ID.Hex()},
+	}
+
+	for _, tc := range requests {
+		t.Run(tc.name+" by another admin", func(t *testing.T) {
+			mockPollAdminUsecase := newUsecase()
+
+			rec := httptest.NewRecorder()
+			router := newRouter(&controller.PollAdminController{PollAdminUsecase: mockPollAdminUsecase}, primitive.NewObjectID().Hex())
+			router.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.target, nil))
+
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+			mockPollAdminUsecase.AssertNotCalled(t, "GetBallots", mock.Anything, mock.Anything, mock.Anything)
+			mockPollAdminUsecase.AssertNotCalled(t, "DeleteBallot", mock.Anything, mock.Anything)
+			mockPollAdminUsecase.AssertNotCalled(t, "Recount", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("recount by the owner", func(t *testing.T) {
+		mockPollAdminUsecase := newUsecase()
+		mockPollAdminUsecase.On("Recount", mock.Anything, poll.ID.Hex()).Return(poll, nil)
+
+		rec := httptest.NewRecorder()
+		router := newRouter(&controller.PollAdminController{PollAdminUsecase: mockPollAdminUsecase}, ownerID.Hex())
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/recount?id="+poll.ID.Hex(), nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockPollAdminUsecase.AssertExpectations(t)
+	})
+}
+
+func TestReorderOwnership(t *testing.T) {
+	sheet := domain.Sheet{ID: primitive.NewObjectID(), UserID: primitive.NewObjectID()}
+
+	mockPollAdminUsecase := new(mocks.PollAdminUsecase)
+	mockPollAdminUsecase.On("GetSheet", mock.Anything, sheet.ID.Hex()).Return(sheet, nil)
+
+	pc := &controller.PollAdminController{PollAdminUsecase: mockPollAdminUsecase}
+	router := gin.Default()
+	router.POST("/admin/reorder", func(c *gin.Context) {
+		c.Set("x-user-id", primitive.NewObjectID().Hex())
+		c.Set("x-user-type", string(domain.VerifiedAdmin))
+		pc.Reorder(c)
+	})
+
+	body, err := json.Marshal(domain.PollReorderRequest{SheetID: sheet.ID.Hex(), PollIDs: []string{primitive.NewObjectID().Hex()}})
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/reorder", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	mockPollAdminUsecase.AssertNotCalled(t, "Reorder", mock.Anything, mock.Anything, mock.Anything)
+}
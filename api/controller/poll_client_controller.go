@@ -2,25 +2,30 @@ package controller
 
 import (
 	"errors"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/bootstrap"
 	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"net/http"
 )
 
 type PollClientController struct {
 	PollClientUsecse domain.PollClientUsecase
+	Env              *bootstrap.Env
 }
 
 // Submit records votes for a poll.
 // @Summary Submit poll votes
-// @Description Submit votes for a poll.
+// @Description Submit votes for a poll. A valid respondent phone is required when the sheet is phone-protected. Quiz sheets require the respondent token issued by the fetch endpoint and return the score once every poll has been answered. A poll with a display rule is only accepted once the respondent's earlier answer shows it.
 // @Tags Polls
 // @Accept json
 // @Produce json
 // @Param payload body domain.PollClientRequest true "Votes payload"
-// @Success 200 {object} domain.SuccessResponse
+// @Success 200 {object} domain.PollSubmitResponse
 // @Failure 400 {object} domain.ErrorResponse
+// @Failure 403 {object} domain.ErrorResponse
+// @Failure 409 {object} domain.ErrorResponse
 // @Failure 500 {object} domain.ErrorResponse
 // @Router /api/v1/submit [post]
 func (pcc *PollClientController) Submit(c *gin.Context) {
@@ -32,29 +37,76 @@ func (pcc *PollClientController) Submit(c *gin.Context) {
 		return
 	}
 
-	err = pcc.PollClientUsecse.SubmitVote(c, req)
+	req.Client = domain.BallotClient{
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+	req.RespondentID = pcc.respondentID(c, req.RespondentToken)
+
+	score, err := pcc.PollClientUsecse.SubmitVote(c, req)
 	if err != nil {
-		status := http.StatusInternalServerError
-		if errors.Is(err, domain.ErrNoVotesSubmitted) || errors.Is(err, domain.ErrNoOpinionSubmitted) {
-			status = http.StatusBadRequest
-		}
-		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
+		c.JSON(submitErrorResponse(err))
 		return
 	}
 
-	c.JSON(http.StatusOK, domain.SuccessResponse{Message: "Votes are submitted."})
+	c.JSON(http.StatusOK, domain.PollSubmitResponse{Message: "Votes are submitted.", Quiz: score})
+}
+
+// SubmitSheet records answers for several polls of a sheet at once.
+// @Summary Submit sheet answers
+// @Description Submit answers for multiple polls of a sheet in one request. Either every answer is recorded or none is.
+// @Tags Polls
+// @Accept json
+// @Produce json
+// @Param payload body domain.PollClientSheetRequest true "Sheet answers payload"
+// @Success 200 {object} domain.PollSubmitResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 403 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 409 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/submit/sheet [post]
+func (pcc *PollClientController) SubmitSheet(c *gin.Context) {
+	var req domain.PollClientSheetRequest
+
+	err := c.BindJSON(&req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if req.SheetID == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "sheet id is required"})
+		return
+	}
+
+	req.Client = domain.BallotClient{
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+	req.RespondentID = pcc.respondentID(c, req.RespondentToken)
+
+	score, err := pcc.PollClientUsecse.SubmitSheet(c, req)
+	if err != nil {
+		c.JSON(submitErrorResponse(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.PollSubmitResponse{Message: "Answers are submitted.", Quiz: score})
 }
 
 // Fetch returns polls for a sheet.
 // @Summary Get polls for sheet
-// @Description Retrieve published polls for a sheet.
+// @Description Retrieve polls for a published sheet. Sheets that are pending, outside their opening window, rejected or finished respond with 403 and a distinguishing code. Shuffled polls and options are returned in a stable per-respondent order; submit answers against that order.
 // @Tags Polls
 // @Produce json
 // @Param id query string true "Sheet identifier"
 // @Param page query int false "Page number"
 // @Param page_size query int false "Page size"
+// @Param respondent_token query string false "Respondent token; falls back to the respondent cookie"
 // @Success 200 {object} domain.PollClientListResponse
 // @Failure 400 {object} domain.ErrorResponse
+// @Failure 403 {object} domain.ErrorResponse
 // @Failure 404 {object} domain.ErrorResponse
 // @Failure 500 {object} domain.ErrorResponse
 // @Router /api/v1/client/fetch [get]
@@ -71,14 +123,12 @@ func (pcc *PollClientController) Fetch(c *gin.Context) {
 
 	pagination := extractPagination(c)
 
-	polls, total, err := pcc.PollClientUsecse.GetBySheetID(c, id, pagination)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
-		return
-	}
-
 	sheet, err := pcc.PollClientUsecse.GetSheet(c, id)
 	if err != nil {
+		if status, response, ok := sheetLifecycleErrorResponse(err); ok {
+			c.JSON(status, response)
+			return
+		}
 		status := http.StatusInternalServerError
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			status = http.StatusNotFound
@@ -87,15 +137,60 @@ func (pcc *PollClientController) Fetch(c *gin.Context) {
 		return
 	}
 
+	// Every poll is loaded so display rules can be mapped to shuffled options and
+	// shuffled sheets can be paginated over the respondent's own order.
+	polls, _, err := pcc.PollClientUsecse.GetBySheetID(c, id, domain.PaginationQuery{})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	respondentToken := ""
+	seed := pcc.respondentID(c, c.Query("respondent_token"))
+	if pcc.Env != nil && seed == "" {
+		respondentToken, err = pcc.PollClientUsecse.CreateRespondentToken(pcc.Env.RespondentTokenSecret, pcc.Env.RespondentTokenExpiryHour)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+			return
+		}
+		setRespondentCookie(c, pcc.Env, respondentToken)
+		seed = pcc.respondentID(c, respondentToken)
+	}
+
+	permutations := make(map[primitive.ObjectID][]int, len(polls))
+	for _, poll := range polls {
+		permutations[poll.ID] = poll.OptionPermutation(seed)
+	}
+
+	if sheet.ShufflePolls {
+		polls = domain.ShufflePolls(polls, sheet.ID.Hex(), seed)
+	}
+	total := int64(len(polls))
+	polls = paginatePolls(polls, pagination)
+
 	var result []domain.PollClientResponse
 
 	for _, poll := range polls {
+		showIf := poll.ShowIf
+		if showIf != nil {
+			showIf = &domain.DisplayRule{PollID: showIf.PollID, Options: domain.DisplayIndices(showIf.Options, permutations[showIf.PollID])}
+		}
+
 		result = append(result, domain.PollClientResponse{
-			ID:          poll.ID.Hex(),
-			Title:       poll.Title,
-			Options:     poll.Options,
-			PollType:    poll.PollType,
-			Description: poll.Description,
+			ID:            poll.ID.Hex(),
+			Title:         poll.Title,
+			Options:       domain.PermuteOptions(poll.Options, permutations[poll.ID]),
+			OptionMedia:   domain.PermuteOptions(poll.OptionMediaURLs(), permutations[poll.ID]),
+			Rows:          poll.Rows,
+			AllowOther:    poll.AllowOther,
+			MinSelections: poll.MinSelections,
+			MaxSelections: poll.MaxSelections,
+			ShowIf:        showIf,
+			PollType:      poll.PollType,
+			Required:      poll.Required,
+			Scale:         poll.Scale,
+			Slide:         poll.Slide,
+			Description:   poll.Description,
 		})
 	}
 
@@ -105,9 +200,100 @@ func (pcc *PollClientController) Fetch(c *gin.Context) {
 			ID:              sheet.ID.Hex(),
 			Title:           sheet.Title,
 			IsPhoneRequired: sheet.IsPhoneRequired,
+			IsQuiz:          sheet.IsQuiz,
+			DedupMode:       effectiveDedupMode(sheet.DedupMode),
+			RespondentToken: respondentToken,
 		},
 		Pagination: domain.NewPaginationResult(pagination, total),
 	}
 
 	c.JSON(http.StatusOK, response)
 }
+
+// paginatePolls returns the requested page of polls; an empty query returns every poll.
+func paginatePolls(polls []domain.Poll, pagination domain.PaginationQuery) []domain.Poll {
+	skip := int(pagination.Skip())
+	if skip >= len(polls) {
+		return []domain.Poll{}
+	}
+	polls = polls[skip:]
+
+	if limit := int(pagination.Limit()); limit > 0 && limit < len(polls) {
+		polls = polls[:limit]
+	}
+	return polls
+}
+
+// respondentID resolves the anonymous respondent from the supplied token or the respondent cookie.
+func (pcc *PollClientController) respondentID(c *gin.Context, token string) string {
+	if token == "" {
+		token, _ = c.Cookie(domain.RespondentCookieName)
+	}
+	if token == "" || pcc.Env == nil {
+		return ""
+	}
+
+	id, err := pcc.PollClientUsecse.ExtractRespondentIDFromToken(token, pcc.Env.RespondentTokenSecret)
+	if err != nil {
+		return ""
+	}
+	return id
+}
+
+func effectiveDedupMode(mode domain.DedupMode) domain.DedupMode {
+	if mode == "" {
+		return domain.DedupNone
+	}
+	return mode
+}
+
+// submitErrorResponse maps a submission error to the HTTP status and body returned to the respondent.
+func submitErrorResponse(err error) (int, domain.ErrorResponse) {
+	if status, response, ok := sheetLifecycleErrorResponse(err); ok {
+		return status, response
+	}
+
+	var missing *domain.MissingRequiredPollsError
+	if errors.As(err, &missing) {
+		return http.StatusBadRequest, domain.ErrorResponse{
+			Message:        err.Error(),
+			Code:           domain.ErrorCodeRequiredPollsMissing,
+			MissingPollIDs: missing.PollIDs,
+		}
+	}
+
+	response := domain.ErrorResponse{Message: err.Error()}
+	switch {
+	case errors.Is(err, domain.ErrNoVotesSubmitted), errors.Is(err, domain.ErrNoOpinionSubmitted),
+		errors.Is(err, domain.ErrNoAnswersSubmitted),
+		errors.Is(err, domain.ErrPhoneRequired), errors.Is(err, domain.ErrInvalidPhone),
+		errors.Is(err, domain.ErrRespondentRequired), errors.Is(err, domain.ErrInvalidBallot):
+		return http.StatusBadRequest, response
+	case errors.Is(err, domain.ErrAlreadySubmitted):
+		return http.StatusConflict, response
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return http.StatusNotFound, response
+	default:
+		return http.StatusInternalServerError, response
+	}
+}
+
+// sheetLifecycleErrorResponse reports sheets that are not open to respondents with a
+// 403 and a code telling pending, not yet open, rejected and closed sheets apart.
+func sheetLifecycleErrorResponse(err error) (int, domain.ErrorResponse, bool) {
+	var code string
+	switch {
+	case errors.Is(err, domain.ErrSheetNotApproved):
+		code = domain.ErrorCodeSheetNotApproved
+	case errors.Is(err, domain.ErrSheetNotOpenYet):
+		code = domain.ErrorCodeSheetNotOpenYet
+	case errors.Is(err, domain.ErrSheetClosed):
+		code = domain.ErrorCodeSheetClosed
+	case errors.Is(err, domain.ErrSheetRejected):
+		code = domain.ErrorCodeSheetRejected
+	default:
+		return 0, domain.ErrorResponse{}, false
+	}
+
+	return http.StatusForbidden, domain.ErrorResponse{Message: err.Error(), Code: code}, true
+}
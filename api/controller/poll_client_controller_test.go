@@ -0,0 +1,174 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/api/controller"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSubmit(t *testing.T) {
+
+	t.Run("success", func(t *testing.T) {
+		payload := domain.PollClientRequest{
+			ID:    "poll-id",
+			Votes: []int{0, 1},
+			Phone: "+15551234567",
+		}
+
+		mockPollClientUsecase := new(mocks.PollClientUsecase)
+
+		mockPollClientUsecase.On("SubmitVote", mock.Anything, mock.MatchedBy(func(req domain.PollClientRequest) bool {
+			return req.ID == payload.ID && req.Phone == payload.Phone
+		})).Return(nil, nil)
+
+		gin := gin.Default()
+
+		rec := httptest.NewRecorder()
+
+		pcc := &controller.PollClientController{
+			PollClientUsecse: mockPollClientUsecase,
+		}
+
+		gin.POST("/submit", pcc.Submit)
+
+		body, err := json.Marshal(payload)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/submit", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		gin.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		mockPollClientUsecase.AssertExpectations(t)
+	})
+
+	t.Run("phone required", func(t *testing.T) {
+		payload := domain.PollClientRequest{
+			ID:    "poll-id",
+			Votes: []int{1, 0},
+		}
+
+		mockPollClientUsecase := new(mocks.PollClientUsecase)
+
+		mockPollClientUsecase.On("SubmitVote", mock.Anything, mock.AnythingOfType("domain.PollClientRequest")).Return(nil, domain.ErrPhoneRequired)
+
+		gin := gin.Default()
+
+		rec := httptest.NewRecorder()
+
+		pcc := &controller.PollClientController{
+			PollClientUsecse: mockPollClientUsecase,
+		}
+
+		gin.POST("/submit", pcc.Submit)
+
+		body, err := json.Marshal(payload)
+		assert.NoError(t, err)
+
+		expected, err := json.Marshal(domain.ErrorResponse{Message: domain.ErrPhoneRequired.Error()})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/submit", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		gin.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		assert.Equal(t, string(expected), rec.Body.String())
+
+		mockPollClientUsecase.AssertExpectations(t)
+	})
+
+	t.Run("sheet closed", func(t *testing.T) {
+		payload := domain.PollClientRequest{
+			ID:    "poll-id",
+			Votes: []int{1, 0},
+		}
+
+		mockPollClientUsecase := new(mocks.PollClientUsecase)
+
+		mockPollClientUsecase.On("SubmitVote", mock.Anything, mock.AnythingOfType("domain.PollClientRequest")).Return(nil, domain.ErrSheetClosed)
+
+		gin := gin.Default()
+
+		rec := httptest.NewRecorder()
+
+		pcc := &controller.PollClientController{
+			PollClientUsecse: mockPollClientUsecase,
+		}
+
+		gin.POST("/submit", pcc.Submit)
+
+		body, err := json.Marshal(payload)
+		assert.NoError(t, err)
+
+		expected, err := json.Marshal(domain.ErrorResponse{Message: domain.ErrSheetClosed.Error(), Code: domain.ErrorCodeSheetClosed})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/submit", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		gin.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		assert.Equal(t, string(expected), rec.Body.String())
+
+		mockPollClientUsecase.AssertExpectations(t)
+	})
+
+}
+
+func TestSubmitSheet(t *testing.T) {
+	t.Run("required polls missing", func(t *testing.T) {
+		payload := domain.PollClientSheetRequest{
+			SheetID: "sheet-id",
+			Answers: []domain.PollClientAnswer{{ID: "poll-id", Votes: []int{1, 0}}},
+		}
+
+		missingErr := &domain.MissingRequiredPollsError{PollIDs: []string{"required-poll-id"}}
+
+		mockPollClientUsecase := new(mocks.PollClientUsecase)
+
+		mockPollClientUsecase.On("SubmitSheet", mock.Anything, mock.AnythingOfType("domain.PollClientSheetRequest")).Return(nil, missingErr)
+
+		gin := gin.Default()
+
+		rec := httptest.NewRecorder()
+
+		pcc := &controller.PollClientController{
+			PollClientUsecse: mockPollClientUsecase,
+		}
+
+		gin.POST("/submit/sheet", pcc.SubmitSheet)
+
+		body, err := json.Marshal(payload)
+		assert.NoError(t, err)
+
+		expected, err := json.Marshal(domain.ErrorResponse{
+			Message:        missingErr.Error(),
+			Code:           domain.ErrorCodeRequiredPollsMissing,
+			MissingPollIDs: []string{"required-poll-id"},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/submit/sheet", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		gin.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		assert.Equal(t, string(expected), rec.Body.String())
+
+		mockPollClientUsecase.AssertExpectations(t)
+	})
+}
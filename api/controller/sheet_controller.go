@@ -18,6 +18,10 @@ type SheetController struct {
 	SheetuseCase        domain.SheetUseCase
 	NotificationUsecase domain.NotificationUsecase
 	PollUsecase         domain.PollAdminUsecase
+	TemplateUsecase     domain.TemplateUsecase
+	// PublicBaseURL is the address exports link option images against; without it
+	// the image paths are written as plain text.
+	PublicBaseURL string
 }
 
 // Create registers a new sheet.
@@ -49,146 +53,276 @@ func (sc *SheetController) Create(c *gin.Context) {
 		return
 	}
 
-	title := strings.TrimSpace(payload.EffectiveTitle())
-	if title == "" {
-		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "title is required"})
+	sc.create(c, payload, userID, userType)
+}
+
+// create validates the payload and stores the sheet with its polls for the given admin.
+func (sc *SheetController) create(c *gin.Context, payload domain.SheetCreateRequest, userID string, userType domain.UserType) {
+	now := time.Now()
+
+	sheet, validatedPolls, err := buildSheet(payload, now)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
 		return
 	}
 
-	venue := strings.TrimSpace(payload.Venue)
-	if venue == "" {
-		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "venue is required"})
+	sc.store(c, sheet, validatedPolls, userID, userType, now)
+}
+
+// store saves a validated sheet and its polls for the given admin in one transaction
+// and writes the HTTP response. Sheets created by verified admins are sent for approval.
+func (sc *SheetController) store(c *gin.Context, sheet domain.Sheet, polls []domain.Poll, userID string, userType domain.UserType, now time.Time) {
+	actorID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "invalid user identifier"})
 		return
 	}
 
-	validatedPolls := make([]domain.Poll, 0, len(payload.Polls))
-	for idx, pollReq := range payload.Polls {
-		pollTitle := strings.TrimSpace(pollReq.Title)
-		if pollTitle == "" {
-			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: fmt.Sprintf("poll %d title is required", idx+1)})
-			return
-		}
+	sheet.UserID = actorID
+	setInitialStatus(&sheet, userType, actorID, now)
 
-		trimmedOptions := make([]string, 0, len(pollReq.Options))
-		for _, opt := range pollReq.Options {
-			optionValue := strings.TrimSpace(opt)
-			if optionValue != "" {
-				trimmedOptions = append(trimmedOptions, optionValue)
-			}
-		}
+	for i := range polls {
+		polls[i].SheetID = sheet.ID
+		polls[i].ResetAggregates()
+		polls[i].CreatedAt = now
+		polls[i].UpdatedAt = now
+	}
 
-		pollType, err := domain.ParsePollType(strings.ToLower(strings.TrimSpace(pollReq.PollType)))
-		if err != nil {
-			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: fmt.Sprintf("poll %d has invalid type", idx+1)})
-			return
-		}
+	if err = sc.SheetuseCase.CreateWithPolls(c, sheet, polls); err != nil {
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	var createdPolls []domain.PollAdminResponse
+	for _, poll := range polls {
+		createdPolls = append(createdPolls, mapPollToAdminResponse(poll))
+	}
 
-		minOptions := pollType.MinOptions()
-		if len(trimmedOptions) < minOptions {
-			message := fmt.Sprintf("poll %d requires at least %d option", idx+1, minOptions)
-			if minOptions > 1 {
-				message += "s"
-			}
-			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: message})
+	if userType == domain.VerifiedAdmin && sc.NotificationUsecase != nil {
+		if err = sc.NotificationUsecase.CreateForSheet(c, &sheet); err != nil {
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
 			return
 		}
+	}
+
+	c.JSON(http.StatusCreated, domain.SheetCreateResponse{
+		Message: creationMessage(sheet),
+		Sheet:   sheet,
+		Polls:   createdPolls,
+	})
+}
+
+// maxImportFormOverhead leaves room for the multipart framing and the sheet fields sent
+// alongside an import file.
+const maxImportFormOverhead = 64 << 10
+
+// Import creates a sheet with polls read from an Excel workbook or CSV file.
+// @Summary Import sheet
+// @Description Create a sheet from an uploaded .xlsx or .csv file (up to 2 MB). The first row is a header with the columns title, type, options and categories, plus an optional description; each following row is one poll. Separate multiple options or categories with "|". Only the first sheet of a workbook is read, so a sheet export cannot be imported as is. Rows are validated like polls in a sheet creation request and every invalid row is reported in row_errors; the sheet and its polls are only created if all rows are valid.
+// @Tags Sheets
+// @Accept mpfd
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Workbook (.xlsx, first sheet) or CSV file"
+// @Param title formData string true "Sheet title"
+// @Param venue formData string true "Sheet venue"
+// @Param description formData string false "Sheet description"
+// @Param is_phone_required formData bool false "Require a phone number from respondents"
+// @Param is_quiz formData bool false "Grade the sheet as a quiz"
+// @Param shuffle_polls formData bool false "Show polls in a per-respondent random order"
+// @Param dedup_mode formData string false "Duplicate protection mode"
+// @Param opens_at formData string false "Opening time (RFC 3339)"
+// @Param closes_at formData string false "Closing time (RFC 3339)"
+// @Success 201 {object} domain.SheetCreateResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 413 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/sheet/import [post]
+func (sc *SheetController) Import(c *gin.Context) {
+	userID := c.GetString("x-user-id")
+	userType := domain.UserType(c.GetString("x-user-type"))
+
+	if userID == "" || (userType != domain.VerifiedAdmin && userType != domain.SuperAdmin) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
 
-		categories := normalizeCategories(pollReq.Category)
-		if len(categories) == 0 {
-			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: fmt.Sprintf("poll %d requires at least one category", idx+1)})
+	// Cap the body before the multipart form is parsed, so an oversized upload is
+	// cut off while it is read instead of being spooled to disk first.
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, domain.MaxImportSize+maxImportFormOverhead)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, domain.ErrorResponse{Message: domain.ErrImportTooLarge.Error()})
 			return
 		}
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "file is required"})
+		return
+	}
 
-		validatedPolls = append(validatedPolls, domain.Poll{
-			Title:       pollTitle,
-			Description: strings.TrimSpace(pollReq.Description),
-			Options:     trimmedOptions,
-			PollType:    pollType,
-			Category:    categories,
-		})
+	if header.Size > domain.MaxImportSize {
+		c.JSON(http.StatusRequestEntityTooLarge, domain.ErrorResponse{Message: domain.ErrImportTooLarge.Error()})
+		return
 	}
 
-	actorID, err := primitive.ObjectIDFromHex(userID)
+	var payload domain.SheetCreateRequest
+	if err = c.ShouldBind(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	now := time.Now()
+
+	sheet, err := buildSheetDetails(payload, now)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "invalid user identifier"})
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
 		return
 	}
 
-	ownerID := actorID
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+	defer file.Close()
 
-	now := time.Now()
+	records, err := readImportRecords(header.Filename, file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
 
-	sheet := domain.Sheet{
-		ID:              primitive.NewObjectID(),
-		UserID:          ownerID,
-		Title:           title,
-		Venue:           venue,
-		IsPhoneRequired: payload.IsPhoneRequired,
-		CreatedAt:       now,
-		UpdatedAt:       now,
+	polls, rowErrors, err := parseImportPolls(records)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+		return
 	}
 
-	if userType == domain.SuperAdmin {
-		sheet.Status = domain.SheetStatusPublished
-		sheet.ApprovedBy = actorID
-		sheet.ApprovedAt = now
-	} else {
-		sheet.Status = domain.SheetStatusPending
+	if len(rowErrors) > 0 {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: domain.ErrImportRows.Error(), RowErrors: rowErrors})
+		return
 	}
 
-	if err = sc.SheetuseCase.Create(c, sheet); err != nil {
-		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+	sc.store(c, sheet, polls, userID, userType, now)
+}
+
+// FromTemplate creates a sheet from a published template.
+// @Summary Create sheet from template
+// @Description Create a sheet and its polls from a template (verified admin or super admin). Title and venue default to the template values; the schedule is optional. The sheet is validated and approved like any new sheet.
+// @Tags Sheets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Template identifier"
+// @Param payload body domain.TemplateInstantiateRequest false "Optional overrides for the new sheet"
+// @Success 201 {object} domain.SheetCreateResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/sheet/template/{id} [post]
+func (sc *SheetController) FromTemplate(c *gin.Context) {
+	userID := c.GetString("x-user-id")
+	userType := domain.UserType(c.GetString("x-user-type"))
+
+	if userID == "" || (userType != domain.VerifiedAdmin && userType != domain.SuperAdmin) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
 		return
 	}
 
-	var (
-		createdPolls   []domain.PollAdminResponse
-		createdPollIDs []string
-	)
+	identifier := strings.TrimSpace(c.Param("id"))
+	if identifier == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "id is required"})
+		return
+	}
 
-	if len(validatedPolls) > 0 {
-		if sc.PollUsecase == nil {
-			_ = sc.SheetuseCase.Delete(c, sheet.ID.Hex())
-			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: "poll creation not configured"})
+	var payload domain.TemplateInstantiateRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&payload); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
 			return
 		}
+	}
 
-		for _, pollTemplate := range validatedPolls {
-			poll := pollTemplate
-			poll.ID = primitive.NewObjectID()
-			poll.SheetID = sheet.ID
-			poll.Participant = 0
-			if poll.PollType == domain.PollTypeOpinion {
-				poll.Votes = nil
-				poll.Responses = []string{}
-			} else {
-				poll.Votes = make([]int, poll.PollType.VoteSlots(len(poll.Options)))
-			}
-			poll.CreatedAt = now
-			poll.UpdatedAt = now
-
-			if err = sc.PollUsecase.CreatePoll(c, &poll); err != nil {
-				for _, pollID := range createdPollIDs {
-					_ = sc.PollUsecase.Delete(c, pollID)
-				}
-				_ = sc.SheetuseCase.Delete(c, sheet.ID.Hex())
-				c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
-				return
-			}
-
-			createdPollIDs = append(createdPollIDs, poll.ID.Hex())
-			createdPolls = append(createdPolls, domain.PollAdminResponse{
-				ID:          poll.ID.Hex(),
-				Title:       poll.Title,
-				Options:     poll.Options,
-				PollType:    poll.PollType,
-				Category:    poll.Category,
-				Participant: poll.Participant,
-				Votes:       poll.Votes,
-				Responses:   poll.Responses,
-				Description: poll.Description,
-			})
+	template, err := sc.TemplateUsecase.GetByID(c, identifier)
+	if err != nil {
+		c.JSON(templateErrorStatus(err), domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	sc.create(c, template.SheetRequest(payload), userID, userType)
+}
+
+// Duplicate copies a sheet and its polls into a new sheet owned by the authenticated admin.
+// @Summary Duplicate sheet
+// @Description Copy a sheet (super admin or sheet owner) with all its polls. The copy gets fresh identifiers, empty results and no schedule, and goes through approval like a new sheet. Option images are not copied.
+// @Tags Sheets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Sheet identifier"
+// @Param payload body domain.SheetDuplicateRequest false "Optional title and venue for the copy"
+// @Success 201 {object} domain.SheetCreateResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/sheet/duplicate/{id} [post]
+func (sc *SheetController) Duplicate(c *gin.Context) {
+	userID := c.GetString("x-user-id")
+	userType := domain.UserType(c.GetString("x-user-type"))
+
+	if userID == "" || (userType != domain.VerifiedAdmin && userType != domain.SuperAdmin) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	identifier := strings.TrimSpace(c.Param("id"))
+	if identifier == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "id is required"})
+		return
+	}
+
+	var payload domain.SheetDuplicateRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBind(&payload); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+			return
+		}
+	}
+
+	source, err := sc.SheetuseCase.GetByID(c, identifier)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			status = http.StatusNotFound
 		}
+		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if userType != domain.SuperAdmin && source.UserID.Hex() != userID {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	actorID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "invalid user identifier"})
+		return
+	}
+
+	now := time.Now()
+	sheet := source.Duplicate(payload, actorID, now)
+	setInitialStatus(&sheet, userType, actorID, now)
+
+	polls, err := sc.SheetuseCase.Duplicate(c, identifier, sheet)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		return
 	}
 
 	if userType == domain.VerifiedAdmin && sc.NotificationUsecase != nil {
@@ -198,20 +332,37 @@ func (sc *SheetController) Create(c *gin.Context) {
 		}
 	}
 
-	message := "sheet created!"
-	if sheet.Status == domain.SheetStatusPending {
-		message = "sheet submitted for approval"
-	} else {
-		message = "sheet published"
+	createdPolls := make([]domain.PollAdminResponse, 0, len(polls))
+	for _, poll := range polls {
+		createdPolls = append(createdPolls, mapPollToAdminResponse(poll))
 	}
 
 	c.JSON(http.StatusCreated, domain.SheetCreateResponse{
-		Message: message,
+		Message: creationMessage(sheet),
 		Sheet:   sheet,
 		Polls:   createdPolls,
 	})
 }
 
+// setInitialStatus publishes sheets created by super admins right away and sends
+// everyone else's through approval.
+func setInitialStatus(sheet *domain.Sheet, userType domain.UserType, actorID primitive.ObjectID, now time.Time) {
+	if userType == domain.SuperAdmin {
+		sheet.Status = domain.SheetStatusPublished
+		sheet.ApprovedBy = actorID
+		sheet.ApprovedAt = now
+	} else {
+		sheet.Status = domain.SheetStatusPending
+	}
+}
+
+func creationMessage(sheet domain.Sheet) string {
+	if sheet.Status == domain.SheetStatusPending {
+		return "sheet submitted for approval"
+	}
+	return "sheet published"
+}
+
 // Fetch lists sheets for the authenticated admin.
 // @Summary List sheets
 // @Description Retrieve sheets depending on admin role.
@@ -300,7 +451,7 @@ func (sc *SheetController) FetchByID(c *gin.Context) {
 
 // Export streams an Excel workbook containing sheet details, polls, and responses.
 // @Summary Export sheet
-// @Description Download sheet details alongside poll statistics as an Excel workbook.
+// @Description Download sheet details alongside poll statistics as an Excel workbook. The workbook spreads the sheet over several tabs and is not in the import format.
 // @Tags Sheets
 // @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
 // @Security BearerAuth
@@ -346,7 +497,17 @@ func (sc *SheetController) Export(c *gin.Context) {
 		return
 	}
 
-	workbook, err := buildSheetWorkbook(sheet, polls)
+	ballots := make(map[string][]domain.Ballot, len(polls))
+	for _, poll := range polls {
+		pollBallots, _, err := sc.PollUsecase.GetBallots(c, poll.ID.Hex(), domain.PaginationQuery{})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+			return
+		}
+		ballots[poll.ID.Hex()] = pollBallots
+	}
+
+	workbook, err := buildSheetWorkbook(sheet, polls, ballots, sc.PublicBaseURL)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
 		return
@@ -366,9 +527,9 @@ func (sc *SheetController) Export(c *gin.Context) {
 	c.Data(http.StatusOK, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", buf.Bytes())
 }
 
-// Delete removes a sheet owned by the authenticated admin.
+// Delete moves a sheet owned by the authenticated admin to the trash.
 // @Summary Delete sheet
-// @Description Delete a sheet by identifier.
+// @Description Move a sheet and its polls to the trash. Trashed sheets can be restored until the retention period ends, after which they are deleted permanently together with their responses and pending approval notifications.
 // @Tags Sheets
 // @Produce json
 // @Security BearerAuth
@@ -413,12 +574,176 @@ func (sc *SheetController) Delete(c *gin.Context) {
 		return
 	}
 
-	if err = sc.SheetuseCase.Delete(c, identifier); err != nil {
+	deletedBy, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	if err = sc.SheetuseCase.Trash(c, identifier, deletedBy, time.Now()); err != nil {
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.SuccessResponse{Message: "sheet moved to trash"})
+}
+
+// Trash lists deleted sheets that can still be restored.
+// @Summary List trashed sheets
+// @Description Retrieve trashed sheets, most recently deleted first. Super admins see every sheet, verified admins only their own.
+// @Tags Sheets
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} domain.SheetListResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/sheet/trash [get]
+func (sc *SheetController) Trash(c *gin.Context) {
+	userID := c.GetString("x-user-id")
+	userType := domain.UserType(c.GetString("x-user-type"))
+
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	var owner string
+	if userType == domain.VerifiedAdmin {
+		owner = userID
+	} else if userType != domain.SuperAdmin {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	pagination := extractPagination(c)
+
+	sheets, total, err := sc.SheetuseCase.GetTrash(c, owner, pagination)
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, domain.SuccessResponse{Message: "sheet deleted!"})
+	c.JSON(http.StatusOK, domain.SheetListResponse{
+		Data:       sheets,
+		Pagination: domain.NewPaginationResult(pagination, total),
+	})
+}
+
+// Restore takes a sheet out of the trash.
+// @Summary Restore sheet
+// @Description Restore a trashed sheet (super admin or sheet owner) together with the polls that were deleted with it.
+// @Tags Sheets
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Sheet identifier"
+// @Success 200 {object} domain.SuccessResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/sheet/restore [put]
+func (sc *SheetController) Restore(c *gin.Context) {
+	identifier := strings.TrimSpace(c.Query("id"))
+	if identifier == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "id is required"})
+		return
+	}
+
+	sheet, err := sc.SheetuseCase.GetTrashedByID(c, identifier)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	userID := c.GetString("x-user-id")
+	userType := domain.UserType(c.GetString("x-user-type"))
+
+	if userID == "" || (userType != domain.SuperAdmin && sheet.UserID.Hex() != userID) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	if err = sc.SheetuseCase.Restore(c, identifier, time.Now()); err != nil {
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.SuccessResponse{Message: "sheet restored"})
+}
+
+// Update changes a sheet's metadata.
+// @Summary Update sheet
+// @Description Partially update a sheet (super admin or sheet owner). Title, venue and description can always change. Finished and rejected sheets accept nothing else; once the sheet has responses the phone requirement, dedup mode and opening time are fixed, and a published sheet that is already open keeps its opening time.
+// @Tags Sheets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Sheet identifier"
+// @Param payload body domain.SheetUpdateRequest true "Fields to change"
+// @Success 200 {object} domain.Sheet
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 409 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/sheet/{id} [patch]
+func (sc *SheetController) Update(c *gin.Context) {
+	identifier := strings.TrimSpace(c.Param("id"))
+	if identifier == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "id is required"})
+		return
+	}
+
+	var payload domain.SheetUpdateRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	sheet, err := sc.SheetuseCase.GetByID(c, identifier)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	userID := c.GetString("x-user-id")
+	userType := domain.UserType(c.GetString("x-user-type"))
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	if userType != domain.SuperAdmin && sheet.UserID.Hex() != userID {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	updated, err := sc.SheetuseCase.Update(c, identifier, payload, time.Now())
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domain.ErrInvalidSheetUpdate):
+			status = http.StatusBadRequest
+		case errors.Is(err, domain.ErrSheetFieldLocked):
+			status = http.StatusConflict
+		case errors.Is(err, mongo.ErrNoDocuments):
+			status = http.StatusNotFound
+		}
+		c.JSON(status, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
 }
 
 // Finish marks a sheet as finished.
@@ -492,7 +817,7 @@ func (sc *SheetController) Finish(c *gin.Context) {
 	}
 
 	now := time.Now()
-	if err = sc.SheetuseCase.UpdateStatus(c, identifier, domain.SheetStatusFinished, actorID, now); err != nil {
+	if err = sc.SheetuseCase.Close(c, identifier, actorID, domain.SheetClosedManually, now); err != nil {
 		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
 		return
 	}
@@ -0,0 +1,184 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/api/controller"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/xuri/excelize/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func importRequest(t *testing.T, filename string, content string) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	assert.NoError(t, writer.WriteField("title", "Weekly check-in"))
+	assert.NoError(t, writer.WriteField("venue", "Hall A"))
+
+	part, err := writer.CreateFormFile("file", filename)
+	assert.NoError(t, err)
+	_, err = part.Write([]byte(content))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/sheet/import", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestImport(t *testing.T) {
+	userID := primitive.NewObjectID().Hex()
+
+	newRouter := func(sc *controller.SheetController) *gin.Engine {
+		router := gin.Default()
+		router.POST("/sheet/import", func(c *gin.Context) {
+			c.Set("x-user-id", userID)
+			c.Set("x-user-type", string(domain.SuperAdmin))
+			sc.Import(c)
+		})
+		return router
+	}
+
+	t.Run("success", func(t *testing.T) {
+		content := "\ufeffTitle,Type,Options,Categories,Description\n" +
+			"Attending?,single_choice,Yes|No,general,\n" +
+			"\n" +
+			"Favourite talks,multi_choice,\"Keynote|Panel, Q&A\",general|talks,Pick any\n"
+
+		mockSheetUseCase := new(mocks.SheetUseCase)
+		mockSheetUseCase.On("CreateWithPolls", mock.Anything, mock.MatchedBy(func(sheet domain.Sheet) bool {
+			return sheet.Title == "Weekly check-in" && sheet.UserID.Hex() == userID && sheet.Status == domain.SheetStatusPublished
+		}), mock.MatchedBy(func(polls []domain.Poll) bool {
+			return len(polls) == 2 &&
+				polls[0].Position == 1 && polls[0].PollType == domain.PollTypeSingleChoice &&
+				assert.ObjectsAreEqual([]string{"Keynote", "Panel, Q&A"}, polls[1].Options) &&
+				assert.ObjectsAreEqual([]string{"general", "talks"}, polls[1].Category) &&
+				polls[1].Description == "Pick any"
+		})).Return(nil)
+
+		rec := httptest.NewRecorder()
+		newRouter(&controller.SheetController{SheetuseCase: mockSheetUseCase}).ServeHTTP(rec, importRequest(t, "polls.csv", content))
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var response domain.SheetCreateResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Len(t, response.Polls, 2)
+
+		mockSheetUseCase.AssertExpectations(t)
+	})
+
+	t.Run("row errors", func(t *testing.T) {
+		content := "title,type,options,categories\n" +
+			"Attending?,single_choice,Yes|No,general\n" +
+			",single_choice,Yes|No,general\n" +
+			"Mood,unknown,Good|Bad,general\n"
+
+		mockSheetUseCase := new(mocks.SheetUseCase)
+
+		rec := httptest.NewRecorder()
+		newRouter(&controller.SheetController{SheetuseCase: mockSheetUseCase}).ServeHTTP(rec, importRequest(t, "polls.csv", content))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		expected, err := json.Marshal(domain.ErrorResponse{
+			Message: domain.ErrImportRows.Error(),
+			RowErrors: []domain.ImportRowError{
+				{Row: 3, Message: "row 3 title is required"},
+				{Row: 4, Message: "row 4 has invalid type"},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, string(expected), rec.Body.String())
+
+		mockSheetUseCase.AssertNotCalled(t, "CreateWithPolls", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("workbook", func(t *testing.T) {
+		workbook := excelize.NewFile()
+		sheetName := workbook.GetSheetName(0)
+		assert.NoError(t, workbook.SetSheetRow(sheetName, "A1", &[]string{"title", "type", "options", "categories"}))
+		assert.NoError(t, workbook.SetSheetRow(sheetName, "A2", &[]string{"Attending?", "single_choice", "Yes|No", "general"}))
+		buf, err := workbook.WriteToBuffer()
+		assert.NoError(t, err)
+
+		mockSheetUseCase := new(mocks.SheetUseCase)
+		mockSheetUseCase.On("CreateWithPolls", mock.Anything, mock.AnythingOfType("domain.Sheet"), mock.MatchedBy(func(polls []domain.Poll) bool {
+			return len(polls) == 1 && polls[0].Title == "Attending?"
+		})).Return(nil)
+
+		rec := httptest.NewRecorder()
+		newRouter(&controller.SheetController{SheetuseCase: mockSheetUseCase}).ServeHTTP(rec, importRequest(t, "polls.xlsx", buf.String()))
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		mockSheetUseCase.AssertExpectations(t)
+	})
+
+	t.Run("unsupported file", func(t *testing.T) {
+		mockSheetUseCase := new(mocks.SheetUseCase)
+
+		rec := httptest.NewRecorder()
+		newRouter(&controller.SheetController{SheetuseCase: mockSheetUseCase}).ServeHTTP(rec, importRequest(t, "polls.txt", "title\n"))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		mockSheetUseCase := new(mocks.SheetUseCase)
+
+		content := strings.Repeat("a", domain.MaxImportSize+128<<10)
+		rec := httptest.NewRecorder()
+		newRouter(&controller.SheetController{SheetuseCase: mockSheetUseCase}).ServeHTTP(rec, importRequest(t, "polls.csv", content))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		mockSheetUseCase.AssertNotCalled(t, "CreateWithPolls", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestExport(t *testing.T) {
+	userID := primitive.NewObjectID()
+	sheet := domain.Sheet{ID: primitive.NewObjectID(), UserID: userID, Title: "Weekly check-in"}
+	poll := domain.Poll{
+		ID:          primitive.NewObjectID(),
+		SheetID:     sheet.ID,
+		Title:       "Attending?",
+		PollType:    domain.PollTypeSingleChoice,
+		Options:     []string{"Yes", "No"},
+		Votes:       []int{1, 0},
+		OptionMedia: []domain.OptionMedia{{Option: 0, Key: primitive.NewObjectID().Hex() + ".png"}},
+	}
+
+	mockSheetUseCase := new(mocks.SheetUseCase)
+	mockSheetUseCase.On("GetByID", mock.Anything, sheet.ID.Hex()).Return(sheet, nil)
+	mockPollUsecase := new(mocks.PollAdminUsecase)
+	mockPollUsecase.On("GetBySheetID", mock.Anything, sheet.ID.Hex(), domain.PaginationQuery{}).Return([]domain.Poll{poll}, int64(1), nil)
+	mockPollUsecase.On("GetBallots", mock.Anything, poll.ID.Hex(), domain.PaginationQuery{}).Return([]domain.Ballot{}, int64(0), nil)
+
+	// Without a public base URL the export still renders, with plain image paths.
+	sc := &controller.SheetController{SheetuseCase: mockSheetUseCase, PollUsecase: mockPollUsecase}
+	router := gin.Default()
+	router.GET("/sheet/export/:id", func(c *gin.Context) {
+		c.Set("x-user-id", userID.Hex())
+		c.Set("x-user-type", string(domain.VerifiedAdmin))
+		sc.Export(c)
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sheet/export/"+sheet.ID.Hex(), nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	_, err := excelize.OpenReader(bytes.NewReader(rec.Body.Bytes()))
+	assert.NoError(t, err)
+}
@@ -2,15 +2,21 @@ package controller
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
 
 	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
 	"github.com/xuri/excelize/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
-func buildSheetWorkbook(sheet domain.Sheet, polls []domain.Poll) (*excelize.File, error) {
+// buildSheetWorkbook renders the sheet export. mediaBaseURL is the public address of the
+// service that option image links are resolved against; when empty the image paths are
+// written without a link. The workbook is a report, not an import file: import reads
+// only the first tab and expects one poll per row.
+func buildSheetWorkbook(sheet domain.Sheet, polls []domain.Poll, ballots map[string][]domain.Ballot, mediaBaseURL string) (*excelize.File, error) {
 	workbook := excelize.NewFile()
 
 	summarySheetName := "Summary"
@@ -34,6 +40,8 @@ func buildSheetWorkbook(sheet domain.Sheet, polls []domain.Poll) (*excelize.File
 	}
 	row = writeLabelValueRow(workbook, summarySheetName, row, "Status", string(sheet.Status))
 	row = writeLabelValueRow(workbook, summarySheetName, row, "Phone Required", yesNo(sheet.IsPhoneRequired))
+	row = writeLabelValueRow(workbook, summarySheetName, row, "Duplicate Protection", string(effectiveDedupMode(sheet.DedupMode)))
+	row = writeLabelValueRow(workbook, summarySheetName, row, "Quiz", yesNo(sheet.IsQuiz))
 	if !sheet.CreatedAt.IsZero() {
 		row = writeLabelValueRow(workbook, summarySheetName, row, "Created At", formatDateTime(sheet.CreatedAt))
 	}
@@ -43,6 +51,18 @@ func buildSheetWorkbook(sheet domain.Sheet, polls []domain.Poll) (*excelize.File
 	if !sheet.ApprovedAt.IsZero() {
 		row = writeLabelValueRow(workbook, summarySheetName, row, "Approved At", formatDateTime(sheet.ApprovedAt))
 	}
+	if sheet.OpensAt != nil {
+		row = writeLabelValueRow(workbook, summarySheetName, row, "Opens At", formatDateTime(*sheet.OpensAt))
+	}
+	if sheet.ClosesAt != nil {
+		row = writeLabelValueRow(workbook, summarySheetName, row, "Closes At", formatDateTime(*sheet.ClosesAt))
+	}
+	if !sheet.ClosedAt.IsZero() {
+		row = writeLabelValueRow(workbook, summarySheetName, row, "Closed At", formatDateTime(sheet.ClosedAt))
+		if sheet.CloseReason != "" {
+			row = writeLabelValueRow(workbook, summarySheetName, row, "Closed By", string(sheet.CloseReason))
+		}
+	}
 
 	if row > 1 {
 		row++
@@ -66,6 +86,11 @@ func buildSheetWorkbook(sheet domain.Sheet, polls []domain.Poll) (*excelize.File
 
 	usedSheetNames := map[string]int{summarySheetName: 1}
 
+	pollsByID := make(map[primitive.ObjectID]domain.Poll, len(polls))
+	for _, poll := range polls {
+		pollsByID[poll.ID] = poll
+	}
+
 	for idx, poll := range polls {
 		fallback := fmt.Sprintf("Poll %d", idx+1)
 		sheetName := uniqueSheetName(poll.Title, fallback, usedSheetNames)
@@ -75,7 +100,8 @@ func buildSheetWorkbook(sheet domain.Sheet, polls []domain.Poll) (*excelize.File
 		}
 
 		_ = workbook.SetColWidth(sheetName, "A", "A", 24)
-		_ = workbook.SetColWidth(sheetName, "B", "C", 80)
+		_ = workbook.SetColWidth(sheetName, "B", "B", 80)
+		_ = workbook.SetColWidth(sheetName, "C", "D", 24)
 
 		row := 1
 		row = writeLabelValueRow(workbook, sheetName, row, "Title", poll.Title)
@@ -83,16 +109,51 @@ func buildSheetWorkbook(sheet domain.Sheet, polls []domain.Poll) (*excelize.File
 			row = writeLabelValueRow(workbook, sheetName, row, "Description", poll.Description)
 		}
 		row = writeLabelValueRow(workbook, sheetName, row, "Type", string(poll.PollType))
+		if poll.Required {
+			row = writeLabelValueRow(workbook, sheetName, row, "Required", yesNo(true))
+		}
+		if poll.Slide != nil {
+			row = writeLabelValueRow(workbook, sheetName, row, "Slide Range", fmt.Sprintf("%s to %s, step %s", poll.Slide.Format(poll.Slide.Min), poll.Slide.Format(poll.Slide.Max), strconv.FormatFloat(poll.Slide.Step, 'f', -1, 64)))
+		}
 		if len(poll.Category) > 0 {
 			row = writeLabelValueRow(workbook, sheetName, row, "Categories", strings.Join(poll.Category, ", "))
 		}
+		if poll.ShowIf != nil {
+			if source, ok := pollsByID[poll.ShowIf.PollID]; ok {
+				row = writeLabelValueRow(workbook, sheetName, row, "Shown If", fmt.Sprintf("%s is %s", source.Title, optionLabels(source, poll.ShowIf.Options, " or ")))
+			}
+		}
+		if limits := poll.SelectionLimitLabel(); limits != "" {
+			row = writeLabelValueRow(workbook, sheetName, row, "Selections", limits)
+		}
 		row = writeLabelValueRow(workbook, sheetName, row, "Participants", poll.Participant)
+		if poll.IsGradable() {
+			row = writeLabelValueRow(workbook, sheetName, row, "Correct Answer", optionLabels(poll, poll.CorrectOptions, ", "))
+			row = writeLabelValueRow(workbook, sheetName, row, "Points", poll.Points)
+			if rate := poll.CorrectRate(); rate != nil {
+				row = writeLabelValueRow(workbook, sheetName, row, "Correct Rate", fmt.Sprintf("%.1f%%", *rate*100))
+			}
+		}
 
 		row++
 
-		if len(poll.Options) > 0 {
-			_ = workbook.SetCellValue(sheetName, cellRef("A", row), "Option")
+		if poll.PollType == domain.PollTypeRanking {
+			row = writeRankingTable(workbook, sheetName, row, poll)
+		} else if poll.PollType == domain.PollTypeMatrix {
+			row = writeMatrixTable(workbook, sheetName, row, poll)
+		} else if len(poll.Options) > 0 {
+			optionHeader := "Option"
+			if poll.PollType.IsScale() {
+				optionHeader = "Score"
+			} else if poll.Slide != nil {
+				optionHeader = "Range"
+			}
+			_ = workbook.SetCellValue(sheetName, cellRef("A", row), optionHeader)
 			_ = workbook.SetCellValue(sheetName, cellRef("B", row), "Votes")
+			mediaURLs := poll.OptionMediaURLs()
+			if mediaURLs != nil {
+				_ = workbook.SetCellValue(sheetName, cellRef("C", row), "Media")
+			}
 			row++
 			for optIndex, option := range poll.Options {
 				vote := 0
@@ -101,11 +162,60 @@ func buildSheetWorkbook(sheet domain.Sheet, polls []domain.Poll) (*excelize.File
 				}
 				_ = workbook.SetCellValue(sheetName, cellRef("A", row), option)
 				_ = workbook.SetCellValue(sheetName, cellRef("B", row), vote)
+				if optIndex < len(mediaURLs) && mediaURLs[optIndex] != "" {
+					cell := cellRef("C", row)
+					if mediaBaseURL == "" {
+						_ = workbook.SetCellValue(sheetName, cell, mediaURLs[optIndex])
+					} else {
+						link := strings.TrimRight(mediaBaseURL, "/") + mediaURLs[optIndex]
+						_ = workbook.SetCellValue(sheetName, cell, link)
+						_ = workbook.SetCellHyperLink(sheetName, cell, link, "External")
+					}
+				}
 				row++
 			}
 		}
 
-		if len(poll.Responses) > 0 {
+		if len(poll.OtherResponses) > 0 {
+			row++
+			_ = workbook.SetCellValue(sheetName, cellRef("A", row), "Other #")
+			_ = workbook.SetCellValue(sheetName, cellRef("B", row), "Other Response")
+			row++
+			for otherIndex, response := range poll.OtherResponses {
+				_ = workbook.SetCellValue(sheetName, cellRef("A", row), otherIndex+1)
+				_ = workbook.SetCellValue(sheetName, cellRef("B", row), response)
+				row++
+			}
+		}
+
+		if stats := poll.NumericStatistics(); stats != nil {
+			row++
+			row = writeLabelValueRow(workbook, sheetName, row, "Mean", fmt.Sprintf("%.2f", stats.Mean))
+			row = writeLabelValueRow(workbook, sheetName, row, "Median", fmt.Sprintf("%.2f", stats.Median))
+			row = writeLabelValueRow(workbook, sheetName, row, "Standard Deviation", fmt.Sprintf("%.2f", stats.StdDev))
+		}
+		if breakdown := poll.NPSBreakdown(); breakdown != nil {
+			row = writeLabelValueRow(workbook, sheetName, row, "Promoters", breakdown.Promoters)
+			row = writeLabelValueRow(workbook, sheetName, row, "Passives", breakdown.Passives)
+			row = writeLabelValueRow(workbook, sheetName, row, "Detractors", breakdown.Detractors)
+			row = writeLabelValueRow(workbook, sheetName, row, "NPS", fmt.Sprintf("%.1f", breakdown.Score))
+		}
+
+		if pollBallots := ballots[poll.ID.Hex()]; len(pollBallots) > 0 {
+			row++
+			_ = workbook.SetCellValue(sheetName, cellRef("A", row), "Response #")
+			_ = workbook.SetCellValue(sheetName, cellRef("B", row), "Answer")
+			_ = workbook.SetCellValue(sheetName, cellRef("C", row), "Phone")
+			_ = workbook.SetCellValue(sheetName, cellRef("D", row), "Submitted At")
+			row++
+			for ballotIndex, ballot := range pollBallots {
+				_ = workbook.SetCellValue(sheetName, cellRef("A", row), ballotIndex+1)
+				_ = workbook.SetCellValue(sheetName, cellRef("B", row), ballotAnswer(poll, ballot))
+				_ = workbook.SetCellValue(sheetName, cellRef("C", row), ballot.Phone)
+				_ = workbook.SetCellValue(sheetName, cellRef("D", row), formatDateTime(ballot.CreatedAt))
+				row++
+			}
+		} else if len(poll.Responses) > 0 {
 			row++
 			_ = workbook.SetCellValue(sheetName, cellRef("A", row), "Response #")
 			_ = workbook.SetCellValue(sheetName, cellRef("B", row), "Text")
@@ -118,12 +228,170 @@ func buildSheetWorkbook(sheet domain.Sheet, polls []domain.Poll) (*excelize.File
 		}
 	}
 
+	if err := writeLeaderboardSheet(workbook, ballots, usedSheetNames); err != nil {
+		_ = workbook.Close()
+		return nil, err
+	}
+
 	if idx, err := workbook.GetSheetIndex(summarySheetName); err == nil {
 		workbook.SetActiveSheet(idx)
 	}
 	return workbook, nil
 }
 
+// writeRankingTable lists each option with its first-place votes, Borda score and average position.
+func writeRankingTable(workbook *excelize.File, sheetName string, row int, poll domain.Poll) int {
+	_ = workbook.SetCellValue(sheetName, cellRef("A", row), "Option")
+	_ = workbook.SetCellValue(sheetName, cellRef("B", row), "First Place Votes")
+	_ = workbook.SetCellValue(sheetName, cellRef("C", row), "Borda Score")
+	_ = workbook.SetCellValue(sheetName, cellRef("D", row), "Average Position")
+	row++
+
+	averages := poll.AverageRankPositions()
+	for optIndex, option := range poll.Options {
+		firstPlace, borda, average := 0, 0, 0.0
+		if optIndex < len(poll.Votes) {
+			firstPlace = poll.Votes[optIndex]
+		}
+		if poll.Ranking != nil && optIndex < len(poll.Ranking.BordaScores) {
+			borda = poll.Ranking.BordaScores[optIndex]
+		}
+		if optIndex < len(averages) {
+			average = averages[optIndex]
+		}
+		_ = workbook.SetCellValue(sheetName, cellRef("A", row), option)
+		_ = workbook.SetCellValue(sheetName, cellRef("B", row), firstPlace)
+		_ = workbook.SetCellValue(sheetName, cellRef("C", row), borda)
+		_ = workbook.SetCellValue(sheetName, cellRef("D", row), fmt.Sprintf("%.2f", average))
+		row++
+	}
+
+	return row
+}
+
+// writeMatrixTable lays out matrix results with one row per matrix row and one column per choice.
+func writeMatrixTable(workbook *excelize.File, sheetName string, row int, poll domain.Poll) int {
+	_ = workbook.SetCellValue(sheetName, cellRef("A", row), "Row")
+	for colIndex, column := range poll.Options {
+		_ = workbook.SetCellValue(sheetName, matrixCellRef(colIndex, row), column)
+	}
+	row++
+
+	for rowIndex, label := range poll.Rows {
+		_ = workbook.SetCellValue(sheetName, cellRef("A", row), label)
+		for colIndex := range poll.Options {
+			count := 0
+			if rowIndex < len(poll.MatrixVotes) && colIndex < len(poll.MatrixVotes[rowIndex]) {
+				count = poll.MatrixVotes[rowIndex][colIndex]
+			}
+			_ = workbook.SetCellValue(sheetName, matrixCellRef(colIndex, row), count)
+		}
+		row++
+	}
+
+	return row
+}
+
+// matrixCellRef addresses the cell for a matrix column, starting at column B.
+func matrixCellRef(colIndex, row int) string {
+	column, err := excelize.ColumnNumberToName(colIndex + 2)
+	if err != nil {
+		column = "B"
+	}
+	return cellRef(column, row)
+}
+
+// ballotAnswer renders a ballot as the option labels or free text the respondent submitted.
+func ballotAnswer(poll domain.Poll, ballot domain.Ballot) string {
+	if len(ballot.Text) > 0 && !poll.AllowOther {
+		return strings.Join(ballot.Text, "\n")
+	}
+	if ballot.Value != nil && poll.Slide != nil {
+		return poll.Slide.Format(*ballot.Value)
+	}
+	if poll.PollType == domain.PollTypeMatrix {
+		answers := make([]string, 0, len(ballot.Options))
+		for rowIndex, column := range ballot.Options {
+			if rowIndex < len(poll.Rows) && column >= 0 && column < len(poll.Options) {
+				answers = append(answers, poll.Rows[rowIndex]+": "+poll.Options[column])
+			}
+		}
+		return strings.Join(answers, "\n")
+	}
+
+	separator := ", "
+	if poll.PollType == domain.PollTypeRanking {
+		separator = " > "
+	}
+
+	answer := optionLabels(poll, ballot.Options, separator)
+	if poll.AllowOther && len(ballot.Text) > 0 {
+		// "Other" is always the last option, so its text closes the list.
+		answer += ": " + ballot.Text[0]
+	}
+	if ballot.Correct != nil {
+		if *ballot.Correct {
+			answer += " (correct)"
+		} else {
+			answer += " (incorrect)"
+		}
+	}
+	return answer
+}
+
+// optionLabels joins the labels of the given option indices, skipping unknown indices.
+func optionLabels(poll domain.Poll, options []int, separator string) string {
+	labels := make([]string, 0, len(options))
+	for _, option := range options {
+		if option >= 0 && option < len(poll.Options) {
+			labels = append(labels, poll.Options[option])
+		}
+	}
+	return strings.Join(labels, separator)
+}
+
+// writeLeaderboardSheet adds a quiz leaderboard worksheet when any ballot was graded.
+func writeLeaderboardSheet(workbook *excelize.File, ballots map[string][]domain.Ballot, used map[string]int) error {
+	all := make([]domain.Ballot, 0)
+	for _, pollBallots := range ballots {
+		all = append(all, pollBallots...)
+	}
+
+	leaderboard := domain.BuildLeaderboard(all)
+	if len(leaderboard) == 0 {
+		return nil
+	}
+
+	sheetName := uniqueSheetName("Leaderboard", "Leaderboard", used)
+	if _, err := workbook.NewSheet(sheetName); err != nil {
+		return err
+	}
+
+	_ = workbook.SetColWidth(sheetName, "A", "A", 10)
+	_ = workbook.SetColWidth(sheetName, "B", "C", 24)
+	_ = workbook.SetColWidth(sheetName, "D", "F", 14)
+	_ = workbook.SetColWidth(sheetName, "G", "G", 24)
+
+	headers := []string{"Rank", "Respondent", "Phone", "Score", "Correct", "Answered", "Completed At"}
+	for idx, header := range headers {
+		column, _ := excelize.ColumnNumberToName(idx + 1)
+		_ = workbook.SetCellValue(sheetName, cellRef(column, 1), header)
+	}
+
+	for idx, entry := range leaderboard {
+		row := idx + 2
+		_ = workbook.SetCellValue(sheetName, cellRef("A", row), entry.Rank)
+		_ = workbook.SetCellValue(sheetName, cellRef("B", row), entry.RespondentKey)
+		_ = workbook.SetCellValue(sheetName, cellRef("C", row), entry.Phone)
+		_ = workbook.SetCellValue(sheetName, cellRef("D", row), entry.Score)
+		_ = workbook.SetCellValue(sheetName, cellRef("E", row), entry.Correct)
+		_ = workbook.SetCellValue(sheetName, cellRef("F", row), entry.Answered)
+		_ = workbook.SetCellValue(sheetName, cellRef("G", row), formatDateTime(entry.CompletedAt))
+	}
+
+	return nil
+}
+
 func writeLabelValueRow(workbook *excelize.File, sheetName string, row int, label string, value interface{}) int {
 	_ = workbook.SetCellValue(sheetName, cellRef("A", row), label)
 	_ = workbook.SetCellValue(sheetName, cellRef("B", row), value)
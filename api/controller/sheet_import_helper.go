@@ -0,0 +1,185 @@
+package controller
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/xuri/excelize/v2"
+)
+
+// importRecord is one line of an import file together with its 1-based line number.
+type importRecord struct {
+	line  int
+	cells []string
+}
+
+// readImportRecords reads the rows of an .xlsx workbook's first sheet or of a .csv file.
+func readImportRecords(filename string, r io.Reader) ([]importRecord, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".xlsx":
+		return readWorkbookRecords(r)
+	case ".csv":
+		return readCSVRecords(r)
+	default:
+		return nil, fmt.Errorf("%w: file must be .xlsx or .csv", domain.ErrInvalidImportFile)
+	}
+}
+
+func readWorkbookRecords(r io.Reader) ([]importRecord, error) {
+	workbook, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidImportFile, err.Error())
+	}
+	defer func() { _ = workbook.Close() }()
+
+	sheets := workbook.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, fmt.Errorf("%w: workbook has no sheets", domain.ErrInvalidImportFile)
+	}
+
+	rows, err := workbook.GetRows(sheets[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidImportFile, err.Error())
+	}
+
+	records := make([]importRecord, 0, len(rows))
+	for idx, cells := range rows {
+		records = append(records, importRecord{line: idx + 1, cells: cells})
+	}
+	return records, nil
+}
+
+func readCSVRecords(r io.Reader) ([]importRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	var records []importRecord
+	for {
+		cells, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInvalidImportFile, err.Error())
+		}
+		line, _ := reader.FieldPos(0)
+		if len(records) == 0 && len(cells) > 0 {
+			cells[0] = strings.TrimPrefix(cells[0], "\ufeff")
+		}
+		records = append(records, importRecord{line: line, cells: cells})
+	}
+	return records, nil
+}
+
+// parseImportPolls turns import records into validated polls. The first non-empty record
+// is the header naming the columns; every following non-empty record is one poll and is
+// checked with the same rules as sheet creation. All invalid rows are reported together.
+func parseImportPolls(records []importRecord) ([]domain.Poll, []domain.ImportRowError, error) {
+	headerIdx := -1
+	for idx, record := range records {
+		if !blankRecord(record.cells) {
+			headerIdx = idx
+			break
+		}
+	}
+	if headerIdx < 0 {
+		return nil, nil, fmt.Errorf("%w: file is empty", domain.ErrInvalidImportFile)
+	}
+
+	columns, err := importColumns(records[headerIdx].cells)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		polls     []domain.Poll
+		rowErrors []domain.ImportRowError
+	)
+	for _, record := range records[headerIdx+1:] {
+		if blankRecord(record.cells) {
+			continue
+		}
+
+		cell := func(name string) string {
+			idx, ok := columns[name]
+			if !ok || idx >= len(record.cells) {
+				return ""
+			}
+			return strings.TrimSpace(record.cells[idx])
+		}
+
+		request := domain.SheetCreatePoll{
+			Title:       cell(domain.ImportColumnTitle),
+			PollType:    cell(domain.ImportColumnType),
+			Options:     splitImportList(cell(domain.ImportColumnOptions)),
+			Category:    splitImportList(cell(domain.ImportColumnCategories)),
+			Description: cell(domain.ImportColumnDescription),
+		}
+
+		poll, err := buildSheetPoll(request, len(polls)+1, fmt.Sprintf("row %d", record.line))
+		if err != nil {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: record.line, Message: err.Error()})
+			continue
+		}
+		polls = append(polls, poll)
+	}
+
+	if len(polls) == 0 && len(rowErrors) == 0 {
+		return nil, nil, fmt.Errorf("%w: file contains no polls", domain.ErrInvalidImportFile)
+	}
+
+	return polls, rowErrors, nil
+}
+
+// importColumns maps the header's column names to their positions. Title, type, options
+// and categories are required; description is optional.
+func importColumns(header []string) (map[string]int, error) {
+	required := []string{domain.ImportColumnTitle, domain.ImportColumnType, domain.ImportColumnOptions, domain.ImportColumnCategories}
+	known := map[string]struct{}{domain.ImportColumnDescription: {}}
+	for _, name := range required {
+		known[name] = struct{}{}
+	}
+
+	columns := make(map[string]int, len(header))
+	for idx, value := range header {
+		name := strings.ToLower(strings.TrimSpace(value))
+		if name == "" {
+			continue
+		}
+		if _, ok := known[name]; !ok {
+			return nil, fmt.Errorf("%w: unknown column %q", domain.ErrInvalidImportFile, value)
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("%w: duplicate column %q", domain.ErrInvalidImportFile, value)
+		}
+		columns[name] = idx
+	}
+
+	for _, name := range required {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: missing column %q", domain.ErrInvalidImportFile, name)
+		}
+	}
+
+	return columns, nil
+}
+
+func splitImportList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, domain.ImportListSeparator)
+}
+
+func blankRecord(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
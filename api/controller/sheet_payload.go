@@ -0,0 +1,191 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// buildSheet validates a sheet creation payload and returns the unsaved sheet and its
+// polls. The sheet has no owner or status yet; the returned error is meant for a 400
+// response.
+func buildSheet(payload domain.SheetCreateRequest, now time.Time) (domain.Sheet, []domain.Poll, error) {
+	sheet, err := buildSheetDetails(payload, now)
+	if err != nil {
+		return domain.Sheet{}, nil, err
+	}
+
+	polls, err := buildSheetPolls(payload.Polls)
+	if err != nil {
+		return domain.Sheet{}, nil, err
+	}
+
+	return sheet, polls, nil
+}
+
+// buildSheetDetails validates the sheet-level fields of a creation payload, ignoring its polls.
+func buildSheetDetails(payload domain.SheetCreateRequest, now time.Time) (domain.Sheet, error) {
+	title := strings.TrimSpace(payload.EffectiveTitle())
+	if title == "" {
+		return domain.Sheet{}, errors.New("title is required")
+	}
+
+	venue := strings.TrimSpace(payload.Venue)
+	if venue == "" {
+		return domain.Sheet{}, errors.New("venue is required")
+	}
+
+	dedupMode, err := domain.ParseDedupMode(strings.ToLower(strings.TrimSpace(payload.DedupMode)))
+	if err != nil {
+		return domain.Sheet{}, err
+	}
+
+	if payload.OpensAt != nil && payload.ClosesAt != nil && !payload.ClosesAt.After(*payload.OpensAt) {
+		return domain.Sheet{}, errors.New("closes_at must be after opens_at")
+	}
+
+	if payload.ClosesAt != nil && !payload.ClosesAt.After(now) {
+		return domain.Sheet{}, errors.New("closes_at must be in the future")
+	}
+
+	sheet := domain.Sheet{
+		ID:              primitive.NewObjectID(),
+		Title:           title,
+		Venue:           venue,
+		Description:     strings.TrimSpace(payload.Description),
+		IsPhoneRequired: payload.IsPhoneRequired,
+		IsQuiz:          payload.IsQuiz,
+		ShufflePolls:    payload.ShufflePolls,
+		DedupMode:       dedupMode,
+		OpensAt:         payload.OpensAt,
+		ClosesAt:        payload.ClosesAt,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	return sheet, nil
+}
+
+func buildSheetPolls(requests []domain.SheetCreatePoll) ([]domain.Poll, error) {
+	validatedPolls := make([]domain.Poll, 0, len(requests))
+	displayRules := make([]*domain.SheetDisplayRule, 0, len(requests))
+	for idx, pollReq := range requests {
+		poll, err := buildSheetPoll(pollReq, idx+1, fmt.Sprintf("poll %d", idx+1))
+		if err != nil {
+			return nil, err
+		}
+		validatedPolls = append(validatedPolls, poll)
+		displayRules = append(displayRules, pollReq.ShowIf)
+	}
+
+	if err := domain.LinkDisplayRules(validatedPolls, displayRules); err != nil {
+		return nil, err
+	}
+
+	return validatedPolls, nil
+}
+
+// buildSheetPoll validates one poll of a creation payload. label names the poll in
+// error messages, e.g. "poll 2" or "row 3" for imports.
+func buildSheetPoll(pollReq domain.SheetCreatePoll, position int, label string) (domain.Poll, error) {
+	pollTitle := strings.TrimSpace(pollReq.Title)
+	if pollTitle == "" {
+		return domain.Poll{}, fmt.Errorf("%s title is required", label)
+	}
+
+	trimmedOptions := make([]string, 0, len(pollReq.Options))
+	for _, opt := range pollReq.Options {
+		optionValue := strings.TrimSpace(opt)
+		if optionValue != "" {
+			trimmedOptions = append(trimmedOptions, optionValue)
+		}
+	}
+
+	pollType, err := domain.ParsePollType(strings.ToLower(strings.TrimSpace(pollReq.PollType)))
+	if err != nil {
+		return domain.Poll{}, fmt.Errorf("%s has invalid type", label)
+	}
+
+	scale, err := pollType.ResolveScale(pollReq.Scale)
+	if err != nil {
+		return domain.Poll{}, fmt.Errorf("%s: %w", label, err)
+	}
+	if scale != nil {
+		trimmedOptions = scale.OptionLabels()
+	}
+
+	var rows []string
+	if pollType == domain.PollTypeMatrix {
+		rows = normalizeLabels(pollReq.Rows)
+		if len(rows) == 0 {
+			return domain.Poll{}, fmt.Errorf("%s: %w", label, domain.ErrMatrixRowsRequired)
+		}
+	}
+
+	slide, err := pollType.ResolveSlide(pollReq.Slide)
+	if err != nil {
+		return domain.Poll{}, fmt.Errorf("%s: %w", label, err)
+	}
+	if slide != nil {
+		trimmedOptions = slide.BucketLabels()
+	}
+
+	correctOptions, points, err := pollType.ResolveCorrectOptions(pollReq.CorrectOptions, pollReq.Points, len(trimmedOptions))
+	if err != nil {
+		return domain.Poll{}, fmt.Errorf("%s: %w", label, err)
+	}
+
+	minOptions := pollType.MinOptions()
+	if len(trimmedOptions) < minOptions {
+		message := fmt.Sprintf("%s requires at least %d option", label, minOptions)
+		if minOptions > 1 {
+			message += "s"
+		}
+		return domain.Poll{}, errors.New(message)
+	}
+
+	if pollReq.ShuffleOptions && !pollType.SupportsShuffle() {
+		return domain.Poll{}, fmt.Errorf("%s: %w", label, domain.ErrShuffleNotSupported)
+	}
+
+	if pollReq.AllowOther {
+		if !pollType.SupportsOther() {
+			return domain.Poll{}, fmt.Errorf("%s: %w", label, domain.ErrOtherNotSupported)
+		}
+		trimmedOptions = domain.WithOtherOption(trimmedOptions)
+	}
+
+	minSelections, maxSelections, err := pollType.ResolveSelectionLimits(pollReq.MinSelections, pollReq.MaxSelections, len(trimmedOptions))
+	if err != nil {
+		return domain.Poll{}, fmt.Errorf("%s: %w", label, err)
+	}
+
+	categories := normalizeCategories(pollReq.Category)
+	if len(categories) == 0 {
+		return domain.Poll{}, fmt.Errorf("%s requires at least one category", label)
+	}
+
+	return domain.Poll{
+		ID:             primitive.NewObjectID(),
+		Position:       position,
+		Title:          pollTitle,
+		Description:    strings.TrimSpace(pollReq.Description),
+		Options:        trimmedOptions,
+		Rows:           rows,
+		Scale:          scale,
+		Slide:          slide,
+		CorrectOptions: correctOptions,
+		Points:         points,
+		AllowOther:     pollReq.AllowOther,
+		Required:       pollReq.Required,
+		ShuffleOptions: pollReq.ShuffleOptions,
+		MinSelections:  minSelections,
+		MaxSelections:  maxSelections,
+		PollType:       pollType,
+		Category:       categories,
+	}, nil
+}
@@ -0,0 +1,225 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type TemplateController struct {
+	TemplateUsecase domain.TemplateUsecase
+}
+
+// Publish stores a new sheet template.
+// @Summary Publish template
+// @Description Publish a named sheet template (super admin only). The sheet payload is validated like a sheet creation request and may not set opens_at or closes_at.
+// @Tags Templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param payload body domain.TemplateRequest true "Template payload"
+// @Success 201 {object} domain.Template
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/template/create [post]
+func (tc *TemplateController) Publish(c *gin.Context) {
+	if domain.UserType(c.GetString("x-user-type")) != domain.SuperAdmin {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	createdBy, err := primitive.ObjectIDFromHex(c.GetString("x-user-id"))
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	req, ok := bindTemplateRequest(c)
+	if !ok {
+		return
+	}
+
+	template, err := tc.TemplateUsecase.Publish(c, req, createdBy, time.Now())
+	if err != nil {
+		c.JSON(templateErrorStatus(err), domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, template)
+}
+
+// Update replaces a template's content and bumps its version.
+// @Summary Update template
+// @Description Replace a template (super admin only). Each update increases the template version.
+// @Tags Templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Template identifier"
+// @Param payload body domain.TemplateRequest true "Template payload"
+// @Success 200 {object} domain.Template
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 409 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/template/{id} [put]
+func (tc *TemplateController) Update(c *gin.Context) {
+	if domain.UserType(c.GetString("x-user-type")) != domain.SuperAdmin {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	identifier := strings.TrimSpace(c.Param("id"))
+	if identifier == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "id is required"})
+		return
+	}
+
+	req, ok := bindTemplateRequest(c)
+	if !ok {
+		return
+	}
+
+	template, err := tc.TemplateUsecase.Update(c, identifier, req, time.Now())
+	if err != nil {
+		c.JSON(templateErrorStatus(err), domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
+
+// Delete removes a template. Sheets created from it are not affected.
+// @Summary Delete template
+// @Description Delete a template (super admin only).
+// @Tags Templates
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Template identifier"
+// @Success 200 {object} domain.SuccessResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/template/delete [put]
+func (tc *TemplateController) Delete(c *gin.Context) {
+	if domain.UserType(c.GetString("x-user-type")) != domain.SuperAdmin {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	identifier := strings.TrimSpace(c.Query("id"))
+	if identifier == "" {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "id is required"})
+		return
+	}
+
+	if err := tc.TemplateUsecase.Delete(c, identifier); err != nil {
+		c.JSON(templateErrorStatus(err), domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.SuccessResponse{Message: "template deleted"})
+}
+
+// Fetch lists templates for browsing.
+// @Summary List templates
+// @Description Browse templates by name, optionally filtered by category and tag (verified admin or super admin).
+// @Tags Templates
+// @Produce json
+// @Security BearerAuth
+// @Param category query string false "Category"
+// @Param tag query string false "Tag"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} domain.TemplateListResponse
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/template/fetch [get]
+func (tc *TemplateController) Fetch(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	filter := domain.TemplateFilter{
+		Category: strings.ToLower(strings.TrimSpace(c.Query("category"))),
+		Tag:      strings.ToLower(strings.TrimSpace(c.Query("tag"))),
+	}
+	pagination := extractPagination(c)
+
+	templates, total, err := tc.TemplateUsecase.List(c, filter, pagination)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.TemplateListResponse{
+		Data:       templates,
+		Pagination: domain.NewPaginationResult(pagination, total),
+	})
+}
+
+// FetchByID returns a single template.
+// @Summary Get template
+// @Description Retrieve a template with its sheet payload (verified admin or super admin).
+// @Tags Templates
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Template identifier"
+// @Success 200 {object} domain.Template
+// @Failure 401 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /api/v1/template/fetch/{id} [get]
+func (tc *TemplateController) FetchByID(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusUnauthorized, domain.ErrorResponse{Message: "unauthorized"})
+		return
+	}
+
+	template, err := tc.TemplateUsecase.GetByID(c, strings.TrimSpace(c.Param("id")))
+	if err != nil {
+		c.JSON(templateErrorStatus(err), domain.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
+
+// bindTemplateRequest binds the template payload and checks that its sheet would pass
+// sheet creation, writing a 400 response otherwise.
+func bindTemplateRequest(c *gin.Context) (domain.TemplateRequest, bool) {
+	var req domain.TemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+		return req, false
+	}
+
+	if _, _, err := buildSheet(req.Sheet, time.Now()); err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "sheet: " + err.Error()})
+		return req, false
+	}
+
+	return req, true
+}
+
+func templateErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrInvalidTemplate):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrTemplateNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrTemplateConflict):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
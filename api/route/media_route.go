@@ -0,0 +1,34 @@
+package route
+
+import (
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/api/controller"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/bootstrap"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/repository"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func newMediaController(env *bootstrap.Env, timeout time.Duration, db mongo.Database) *controller.MediaController {
+	pr := repository.NewPollRepository(db, domain.CollectionPoll)
+	sr := repository.NewSheetRepository(db, domain.CollectionSheet)
+	return &controller.MediaController{
+		MediaUsecase: usecase.NewMediaUsecase(pr, sr, bootstrap.NewBlobStore(env), timeout),
+	}
+}
+
+func NewAdminMediaRouter(env *bootstrap.Env, timeout time.Duration, db mongo.Database, group *gin.RouterGroup) {
+	mc := newMediaController(env, timeout, db)
+
+	group.POST("/admin/media/upload", mc.Upload)
+	group.PUT("/admin/media/delete", mc.Remove)
+}
+
+func NewClientMediaRouter(env *bootstrap.Env, timeout time.Duration, db mongo.Database, group *gin.RouterGroup) {
+	mc := newMediaController(env, timeout, db)
+
+	group.GET("/media/:key", mc.Download)
+}
@@ -14,23 +14,34 @@ import (
 
 func NewAdminPollRouter(env *bootstrap.Env, timeout time.Duration, db mongo.Database, group *gin.RouterGroup) {
 	apr := repository.NewPollRepository(db, domain.CollectionPoll)
+	br := repository.NewBallotRepository(db, domain.CollectionBallot)
+	sr := repository.NewSheetRepository(db, domain.CollectionSheet)
 	apc := &controller.PollAdminController{
-		PollAdminUsecase: usecase.NewPollAdminUsecase(apr, timeout),
+		PollAdminUsecase: usecase.NewPollAdminUsecase(apr, br, sr, bootstrap.NewBlobStore(env), db.Client(), timeout),
 	}
 
 	group.POST("/create", apc.Create)
 	group.POST("/edit", apc.Edit)
 	group.GET("/admin/fetch", apc.GetBySheetID)
 	group.PUT("/delete", apc.Delete)
+	group.GET("/admin/trash", apc.Trash)
+	group.PUT("/admin/restore", apc.Restore)
+	group.GET("/admin/ballots", apc.GetBallots)
+	group.PUT("/admin/ballots/delete", apc.DeleteBallot)
+	group.POST("/admin/recount", apc.Recount)
+	group.POST("/admin/reorder", apc.Reorder)
 }
 
 func NewClientPollRouter(env *bootstrap.Env, timeout time.Duration, db mongo.Database, group *gin.RouterGroup) {
 	cpr := repository.NewPollRepository(db, domain.CollectionPoll)
 	sr := repository.NewSheetRepository(db, domain.CollectionSheet)
+	br := repository.NewBallotRepository(db, domain.CollectionBallot)
 	cpc := &controller.PollClientController{
-		PollClientUsecse: usecase.NewPollClientUsecase(cpr, sr, timeout),
+		PollClientUsecse: usecase.NewPollClientUsecase(cpr, sr, br, db.Client(), timeout),
+		Env:              env,
 	}
 
 	group.POST("/submit", cpc.Submit)
+	group.POST("/submit/sheet", cpc.SubmitSheet)
 	group.GET("/client/fetch", cpc.Fetch)
 }
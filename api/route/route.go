@@ -16,6 +16,7 @@ func Setup(env *bootstrap.Env, timeout time.Duration, db mongo.Database, gin *gi
 	NewLoginRouter(env, timeout, db, publicRouter)
 	NewRefreshTokenRouter(env, timeout, db, publicRouter)
 	NewClientPollRouter(env, timeout, db, publicRouter)
+	NewClientMediaRouter(env, timeout, db, publicRouter)
 
 	protectedRouter := gin.Group("/api/v1")
 	// Middleware to verify AccessToken
@@ -23,7 +24,9 @@ func Setup(env *bootstrap.Env, timeout time.Duration, db mongo.Database, gin *gi
 	// All Private APIs
 	NewProfileRouter(env, timeout, db, protectedRouter)
 	NewAdminPollRouter(env, timeout, db, protectedRouter)
+	NewAdminMediaRouter(env, timeout, db, protectedRouter)
 	NewNotificationRouter(env, timeout, db, protectedRouter)
 	NewSheetRouter(env, db, timeout, protectedRouter)
+	NewTemplateRouter(env, timeout, db, protectedRouter)
 	NewAdminRouter(env, timeout, db, protectedRouter)
 }
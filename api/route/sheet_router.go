@@ -17,16 +17,26 @@ func NewSheetRouter(env *bootstrap.Env, db mongo.Database, contextTimeout time.D
 	ur := repository.NewUserRepository(db, domain.CollectionUser)
 	nr := repository.NewNotificationRepository(db, domain.CollectionNotification)
 	pr := repository.NewPollRepository(db, domain.CollectionPoll)
+	br := repository.NewBallotRepository(db, domain.CollectionBallot)
+	tr := repository.NewTemplateRepository(db, domain.CollectionTemplate)
 
 	sc := controller.SheetController{
-		SheetuseCase:        usecase.NewSheetUseCase(sr, ur, contextTimeout),
+		SheetuseCase:        usecase.NewSheetUseCase(sr, ur, pr, br, nr, bootstrap.NewBlobStore(env), db.Client(), contextTimeout),
 		NotificationUsecase: usecase.NewNotificationUsecase(nr, ur, sr, contextTimeout),
-		PollUsecase:         usecase.NewPollAdminUsecase(pr, contextTimeout),
+		PollUsecase:         usecase.NewPollAdminUsecase(pr, br, sr, bootstrap.NewBlobStore(env), db.Client(), contextTimeout),
+		TemplateUsecase:     usecase.NewTemplateUsecase(tr, contextTimeout),
+		PublicBaseURL:       env.PublicBaseURL,
 	}
 
 	group.POST("/sheet/create", sc.Create)
+	group.POST("/sheet/import", sc.Import)
+	group.POST("/sheet/duplicate/:id", sc.Duplicate)
+	group.POST("/sheet/template/:id", sc.FromTemplate)
 	group.PUT("/sheet/delete", sc.Delete)
+	group.GET("/sheet/trash", sc.Trash)
+	group.PUT("/sheet/restore", sc.Restore)
 	group.PUT("/sheet/finish", sc.Finish)
+	group.PATCH("/sheet/:id", sc.Update)
 	group.GET("/sheet/export/:id", sc.Export)
 	group.GET("/sheet/fetch", sc.Fetch)
 	group.GET("/sheet/fetch?id={id}", sc.FetchByID)
@@ -0,0 +1,26 @@
+package route
+
+import (
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/api/controller"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/bootstrap"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/repository"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewTemplateRouter(env *bootstrap.Env, timeout time.Duration, db mongo.Database, group *gin.RouterGroup) {
+	tr := repository.NewTemplateRepository(db, domain.CollectionTemplate)
+	tc := &controller.TemplateController{
+		TemplateUsecase: usecase.NewTemplateUsecase(tr, timeout),
+	}
+
+	group.POST("/template/create", tc.Publish)
+	group.PUT("/template/:id", tc.Update)
+	group.PUT("/template/delete", tc.Delete)
+	group.GET("/template/fetch", tc.Fetch)
+	group.GET("/template/fetch/:id", tc.FetchByID)
+}
@@ -0,0 +1,25 @@
+package bootstrap
+
+import (
+	"log"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/internal/blobstore"
+)
+
+const defaultMediaStoragePath = "./storage/media"
+
+// NewBlobStore opens the local directory that holds the images attached to poll options.
+func NewBlobStore(env *Env) domain.BlobStore {
+	storagePath := env.MediaStoragePath
+	if storagePath == "" {
+		storagePath = defaultMediaStoragePath
+	}
+
+	store, err := blobstore.NewLocal(storagePath)
+	if err != nil {
+		log.Fatal("Media storage can't be initialised: ", err)
+	}
+
+	return store
+}
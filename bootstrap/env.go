@@ -2,32 +2,39 @@ package bootstrap
 
 import (
 	"log"
+	"strings"
 
 	"github.com/spf13/viper"
 )
 
 type Env struct {
-	AppEnv                 string `mapstructure:"APP_ENV"`
-	ServerAddress          string `mapstructure:"SERVER_ADDRESS"`
-	ContextTimeout         int    `mapstructure:"CONTEXT_TIMEOUT"`
-	DBHost                 string `mapstructure:"DB_HOST"`
-	DBPort                 string `mapstructure:"DB_PORT"`
-	DBUser                 string `mapstructure:"DB_USER"`
-	DBPass                 string `mapstructure:"DB_PASS"`
-	DBName                 string `mapstructure:"DB_NAME"`
-	AccessTokenExpiryHour  int    `mapstructure:"ACCESS_TOKEN_EXPIRY_HOUR"`
-	RefreshTokenExpiryHour int    `mapstructure:"REFRESH_TOKEN_EXPIRY_HOUR"`
-	AccessTokenSecret      string `mapstructure:"ACCESS_TOKEN_SECRET"`
-	RefreshTokenSecret     string `mapstructure:"REFRESH_TOKEN_SECRET"`
-	CookieDomain           string `mapstructure:"COOKIE_DOMAIN"`
-	CookieSecure           bool   `mapstructure:"COOKIE_SECURE"`
-	CookieSameSite         string `mapstructure:"COOKIE_SAME_SITE"`
-	CORSAllowedOrigins     string `mapstructure:"CORS_ALLOWED_ORIGINS"`
-	SuperAdminPhone        string `mapstructure:"SUPER_ADMIN_PHONE"`
-	SuperAdminPassword     string `mapstructure:"SUPER_ADMIN_PASSWORD"`
-	SuperAdminName         string `mapstructure:"SUPER_ADMIN_NAME"`
-	SuperAdminEmail        string `mapstructure:"SUPER_ADMIN_EMAIL"`
-	SuperAdminOrganization string `mapstructure:"SUPER_ADMIN_ORGANIZATION"`
+	AppEnv                        string `mapstructure:"APP_ENV"`
+	ServerAddress                 string `mapstructure:"SERVER_ADDRESS"`
+	ContextTimeout                int    `mapstructure:"CONTEXT_TIMEOUT"`
+	DBHost                        string `mapstructure:"DB_HOST"`
+	DBPort                        string `mapstructure:"DB_PORT"`
+	DBUser                        string `mapstructure:"DB_USER"`
+	DBPass                        string `mapstructure:"DB_PASS"`
+	DBName                        string `mapstructure:"DB_NAME"`
+	AccessTokenExpiryHour         int    `mapstructure:"ACCESS_TOKEN_EXPIRY_HOUR"`
+	RefreshTokenExpiryHour        int    `mapstructure:"REFRESH_TOKEN_EXPIRY_HOUR"`
+	AccessTokenSecret             string `mapstructure:"ACCESS_TOKEN_SECRET"`
+	RefreshTokenSecret            string `mapstructure:"REFRESH_TOKEN_SECRET"`
+	RespondentTokenExpiryHour     int    `mapstructure:"RESPONDENT_TOKEN_EXPIRY_HOUR"`
+	RespondentTokenSecret         string `mapstructure:"RESPONDENT_TOKEN_SECRET"`
+	CookieDomain                  string `mapstructure:"COOKIE_DOMAIN"`
+	CookieSecure                  bool   `mapstructure:"COOKIE_SECURE"`
+	CookieSameSite                string `mapstructure:"COOKIE_SAME_SITE"`
+	CORSAllowedOrigins            string `mapstructure:"CORS_ALLOWED_ORIGINS"`
+	SheetSchedulerIntervalSeconds int    `mapstructure:"SHEET_SCHEDULER_INTERVAL_SECONDS"`
+	MediaStoragePath              string `mapstructure:"MEDIA_STORAGE_PATH"`
+	PublicBaseURL                 string `mapstructure:"PUBLIC_BASE_URL"`
+	TrashRetentionDays            int    `mapstructure:"TRASH_RETENTION_DAYS"`
+	SuperAdminPhone               string `mapstructure:"SUPER_ADMIN_PHONE"`
+	SuperAdminPassword            string `mapstructure:"SUPER_ADMIN_PASSWORD"`
+	SuperAdminName                string `mapstructure:"SUPER_ADMIN_NAME"`
+	SuperAdminEmail               string `mapstructure:"SUPER_ADMIN_EMAIL"`
+	SuperAdminOrganization        string `mapstructure:"SUPER_ADMIN_ORGANIZATION"`
 }
 
 func NewEnv() *Env {
@@ -44,6 +51,11 @@ func NewEnv() *Env {
 		log.Fatal("Environment can't be loaded: ", err)
 	}
 
+	// Respondent tokens key duplicate protection; an empty secret would let anyone forge them.
+	if strings.TrimSpace(env.RespondentTokenSecret) == "" {
+		log.Fatal("RESPONDENT_TOKEN_SECRET must be set")
+	}
+
 	if env.AppEnv == "development" {
 		log.Println("The App is running in development env")
 	}
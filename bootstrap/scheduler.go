@@ -0,0 +1,70 @@
+package bootstrap
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+)
+
+const (
+	defaultSheetSchedulerInterval = time.Minute
+	trashPurgeInterval            = time.Hour
+)
+
+// StartSheetScheduler finishes published sheets once their ClosesAt has passed. It checks
+// immediately and then on every tick until ctx is cancelled.
+func StartSheetScheduler(ctx context.Context, env *Env, sheetUseCase domain.SheetUseCase) {
+	interval := time.Duration(env.SheetSchedulerIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = defaultSheetSchedulerInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			closed, err := sheetUseCase.CloseExpired(ctx, time.Now())
+			if err != nil {
+				log.Printf("sheet scheduler: failed to close expired sheets: %v", err)
+			} else if closed > 0 {
+				log.Printf("sheet scheduler: closed %d expired sheet(s)", closed)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// StartTrashPurger permanently deletes sheets and polls that have been in the trash for
+// longer than the configured retention period. It runs immediately and then hourly until
+// ctx is cancelled.
+func StartTrashPurger(ctx context.Context, env *Env, sheetUseCase domain.SheetUseCase) {
+	retention := domain.TrashRetention(env.TrashRetentionDays)
+
+	go func() {
+		ticker := time.NewTicker(trashPurgeInterval)
+		defer ticker.Stop()
+
+		for {
+			purged, err := sheetUseCase.PurgeTrash(ctx, time.Now().Add(-retention))
+			if err != nil {
+				log.Printf("trash purger: failed to purge trash: %v", err)
+			} else if purged.Sheets > 0 || purged.Polls > 0 {
+				log.Printf("trash purger: purged %d sheet(s), %d poll(s) and %d ballot(s)", purged.Sheets, purged.Polls, purged.Ballots)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/url"
 	"strings"
@@ -9,7 +10,10 @@ import (
 	route "github.com/amitshekhariitbhu/go-backend-clean-architecture/api/route"
 	"github.com/amitshekhariitbhu/go-backend-clean-architecture/bootstrap"
 	_ "github.com/amitshekhariitbhu/go-backend-clean-architecture/docs"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
 	"github.com/amitshekhariitbhu/go-backend-clean-architecture/internal/validation"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/repository"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/usecase"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -36,6 +40,27 @@ func main() {
 
 	timeout := time.Duration(env.ContextTimeout) * time.Second
 
+	indexCtx, cancelIndexes := context.WithTimeout(context.Background(), timeout)
+	if err := repository.NewBallotRepository(db, domain.CollectionBallot).EnsureIndexes(indexCtx); err != nil {
+		log.Fatalf("failed to create ballot indexes: %v", err)
+	}
+	cancelIndexes()
+
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+	sheetUseCase := usecase.NewSheetUseCase(
+		repository.NewSheetRepository(db, domain.CollectionSheet),
+		repository.NewUserRepository(db, domain.CollectionUser),
+		repository.NewPollRepository(db, domain.CollectionPoll),
+		repository.NewBallotRepository(db, domain.CollectionBallot),
+		repository.NewNotificationRepository(db, domain.CollectionNotification),
+		bootstrap.NewBlobStore(env),
+		db.Client(),
+		timeout,
+	)
+	bootstrap.StartSheetScheduler(schedulerCtx, env, sheetUseCase)
+	bootstrap.StartTrashPurger(schedulerCtx, env, sheetUseCase)
+
 	gin := gin.Default()
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
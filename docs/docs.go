@@ -16,25 +16,25 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/admin/fetch": {
+        "/api/v1/admin/ballots": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve all polls created for a sheet.",
+                "description": "Retrieve the per-respondent ballots recorded for a poll (super admin or sheet owner).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Polls (Admin)"
                 ],
-                "summary": "List polls for sheet",
+                "summary": "List poll ballots",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Sheet identifier",
+                        "description": "Poll identifier",
                         "name": "id",
                         "in": "query",
                         "required": true
@@ -56,7 +56,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.PollAdminListResponse"
+                            "$ref": "#/definitions/domain.BallotListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
                     "401": {
@@ -65,6 +71,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -74,40 +86,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/admin/users": {
-            "get": {
+        "/api/v1/admin/ballots/delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve users with pagination (super admin only).",
+                "description": "Remove a single ballot and recount the poll it belongs to (super admin or sheet owner).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Polls (Admin)"
                 ],
-                "summary": "List users",
+                "summary": "Delete ballot",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size",
-                        "name": "page_size",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Ballot identifier",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.UserListResponse"
+                            "$ref": "#/definitions/domain.PollAdminResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
                     "401": {
@@ -116,6 +129,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -125,53 +144,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/admin/users/{id}/status": {
-            "put": {
+        "/api/v1/admin/fetch": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Activate or deactivate a user by toggling their verification status.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieve all polls created for a sheet. Sheets with graded quiz questions also return a leaderboard.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Polls (Admin)"
                 ],
-                "summary": "Update user status",
+                "summary": "List polls for sheet",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User identifier",
+                        "description": "Sheet identifier",
                         "name": "id",
-                        "in": "path",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Status payload",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/domain.AdminRequest"
-                        }
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.SuccessResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/domain.ErrorResponse"
+                            "$ref": "#/definitions/domain.PollAdminListResponse"
                         }
                     },
                     "401": {
@@ -189,42 +202,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/client/fetch": {
-            "get": {
-                "description": "Retrieve published polls for a sheet.",
+        "/api/v1/admin/media/delete": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the image attached to one option of a poll (super admin or sheet owner).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Polls"
+                    "Polls (Admin)"
                 ],
-                "summary": "Get polls for sheet",
+                "summary": "Remove option media",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Sheet identifier",
-                        "name": "id",
+                        "description": "Poll identifier",
+                        "name": "poll_id",
                         "in": "query",
                         "required": true
                     },
                     {
                         "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size",
-                        "name": "page_size",
-                        "in": "query"
+                        "description": "Zero-based option index",
+                        "name": "option",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.PollClientListResponse"
+                            "$ref": "#/definitions/domain.PollAdminResponse"
                         }
                     },
                     "400": {
@@ -233,6 +246,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -248,14 +267,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/create": {
+        "/api/v1/admin/media/upload": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a poll associated with a sheet.",
+                "description": "Attach an image (PNG, JPEG, GIF or WebP, up to 5 MB) to one option of a poll (super admin or sheet owner). An existing attachment on the option is replaced.",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -265,63 +284,35 @@ const docTemplate = `{
                 "tags": [
                     "Polls (Admin)"
                 ],
-                "summary": "Create poll",
+                "summary": "Upload option media",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Sheet identifier",
-                        "name": "sheet_id",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Poll title",
-                        "name": "title",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Poll options",
-                        "name": "options",
+                        "description": "Poll identifier",
+                        "name": "poll_id",
                         "in": "formData",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Poll type",
-                        "name": "poll_type",
+                        "type": "integer",
+                        "description": "Zero-based option index",
+                        "name": "option",
                         "in": "formData",
                         "required": true
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Poll categories (repeat parameter for multiple values)",
-                        "name": "category",
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "file",
                         "in": "formData",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Poll description",
-                        "name": "description",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.SuccessResponse"
+                            "$ref": "#/definitions/domain.PollAdminResponse"
                         }
                     },
                     "400": {
@@ -336,6 +327,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -345,21 +354,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/delete": {
-            "put": {
+        "/api/v1/admin/recount": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a poll by identifier.",
+                "description": "Rebuild the participant, vote and response aggregates of a poll from its stored ballots (super admin or sheet owner).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Polls (Admin)"
                 ],
-                "summary": "Delete poll",
+                "summary": "Recount poll",
                 "parameters": [
                     {
                         "type": "string",
@@ -373,7 +382,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.SuccessResponse"
+                            "$ref": "#/definitions/domain.PollAdminResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
                     "401": {
@@ -382,6 +397,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -391,16 +412,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/edit": {
+        "/api/v1/admin/reorder": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update fields of an existing poll.",
+                "description": "Persist a new order for the polls of a sheet (super admin or sheet owner). The payload must list every poll of the sheet exactly once.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
@@ -408,70 +429,84 @@ const docTemplate = `{
                 "tags": [
                     "Polls (Admin)"
                 ],
-                "summary": "Update poll",
+                "summary": "Reorder polls",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Poll identifier",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sheet identifier",
-                        "name": "sheet_id",
-                        "in": "formData",
-                        "required": true
+                        "description": "New poll order",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.PollReorderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.PollAdminResponse"
+                            }
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Poll title",
-                        "name": "title",
-                        "in": "formData",
-                        "required": true
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
                     },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Poll options",
-                        "name": "options",
-                        "in": "formData",
-                        "required": true
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Poll type",
-                        "name": "poll_type",
-                        "in": "formData",
-                        "required": true
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/restore": {
+            "put": {
+                "security": [
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Poll categories (repeat parameter for multiple values)",
-                        "name": "category",
-                        "in": "formData",
-                        "required": true
-                    },
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore a trashed poll (super admin or sheet owner). Polls of a trashed sheet can only be restored together with the sheet.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Polls (Admin)"
+                ],
+                "summary": "Restore poll",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Poll description",
-                        "name": "description",
-                        "in": "formData"
+                        "description": "Poll identifier",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.SuccessResponse"
+                            "$ref": "#/definitions/domain.PollAdminResponse"
                         }
                     },
                     "400": {
@@ -486,6 +521,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -495,37 +542,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/login": {
-            "post": {
-                "description": "Authenticate a user using email and password and receive tokens.",
-                "consumes": [
-                    "multipart/form-data"
+        "/api/v1/admin/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Retrieve the trashed polls of a sheet (super admin or sheet owner).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Polls (Admin)"
                 ],
-                "summary": "Login user",
+                "summary": "List trashed polls",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Registered email address",
-                        "name": "email",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Registered phone number",
-                        "name": "phone",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Password",
-                        "name": "password",
-                        "in": "formData",
+                        "description": "Sheet identifier",
+                        "name": "id",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -533,7 +570,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.LoginResponse"
+                            "$ref": "#/definitions/domain.PollTrashResponse"
                         }
                     },
                     "400": {
@@ -553,25 +590,31 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/poll/notifications": {
+        "/api/v1/admin/users": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve pending notifications (super admin only).",
+                "description": "Retrieve users with pagination (super admin only).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Users"
                 ],
-                "summary": "List pending notifications",
+                "summary": "List users",
                 "parameters": [
                     {
                         "type": "integer",
@@ -590,7 +633,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.NotificationListResponse"
+                            "$ref": "#/definitions/domain.UserListResponse"
                         }
                     },
                     "401": {
@@ -599,12 +642,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/domain.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -614,51 +651,57 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/poll/notifications/{id}/approve": {
-            "post": {
+        "/api/v1/admin/users/{id}/status": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Approve a pending notification (super admin only).",
+                "description": "Activate or deactivate a user by toggling their verification status.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Users"
                 ],
-                "summary": "Approve notification",
+                "summary": "Update user status",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notification identifier",
+                        "description": "User identifier",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Status payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.AdminRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.NotificationResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/domain.ErrorResponse"
+                            "$ref": "#/definitions/domain.SuccessResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
@@ -672,51 +715,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/poll/notifications/{id}/reject": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Reject a pending notification (super admin only).",
+        "/api/v1/client/fetch": {
+            "get": {
+                "description": "Retrieve polls for a published sheet. Sheets that are pending, outside their opening window, rejected or finished respond with 403 and a distinguishing code. Shuffled polls and options are returned in a stable per-respondent order; submit answers against that order.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Polls"
                 ],
-                "summary": "Reject notification",
+                "summary": "Get polls for sheet",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notification identifier",
+                        "description": "Sheet identifier",
                         "name": "id",
-                        "in": "path",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Respondent token; falls back to the respondent cookie",
+                        "name": "respondent_token",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.NotificationResponse"
+                            "$ref": "#/definitions/domain.PollClientListResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
@@ -730,46 +786,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/profile": {
-            "get": {
+        "/api/v1/create": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve the profile for the authenticated user.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Profile"
-                ],
-                "summary": "Get current user profile",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/domain.Profile"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/domain.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/domain.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/refresh": {
-            "post": {
-                "description": "Exchange an existing refresh token for a new access/refresh token pair.",
+                "description": "Create a poll associated with a sheet.",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -777,24 +801,175 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Polls (Admin)"
                 ],
-                "summary": "Refresh authentication tokens",
+                "summary": "Create poll",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Refresh token when cookie is unavailable",
-                        "name": "refreshToken",
-                        "in": "formData"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/domain.RefreshTokenResponse"
-                        }
-                    },
+                        "description": "Sheet identifier",
+                        "name": "sheet_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll title",
+                        "name": "title",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Poll options (matrix columns for matrix polls)",
+                        "name": "options",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Matrix row labels (matrix polls)",
+                        "name": "rows",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll type",
+                        "name": "poll_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Poll categories (repeat parameter for multiple values)",
+                        "name": "category",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll description",
+                        "name": "description",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rating scale minimum (rating polls, default 1)",
+                        "name": "scale_min",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rating scale maximum (rating polls, default 5)",
+                        "name": "scale_max",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rating scale step (rating polls, default 1)",
+                        "name": "scale_step",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Label for each scale point (rating and nps polls)",
+                        "name": "scale_labels",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Slide minimum (slide polls, default 0)",
+                        "name": "slide_min",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Slide maximum (slide polls, default 100)",
+                        "name": "slide_max",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Slide step (slide polls, default 1)",
+                        "name": "slide_step",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unit shown next to slide values",
+                        "name": "slide_unit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Indices of the correct options (quiz questions)",
+                        "name": "correct_options",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Points for a correct answer (quiz questions, default 1)",
+                        "name": "points",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Whether the poll must be answered when submitting the sheet",
+                        "name": "required",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Show the options in a per-respondent random order (single choice, multi choice, ranking and matrix polls)",
+                        "name": "shuffle_options",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Append an \\",
+                        "name": "allow_other",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum number of selected options (multi choice polls)",
+                        "name": "min_selections",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of selected options (multi choice polls)",
+                        "name": "max_selections",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SuccessResponse"
+                        }
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -806,18 +981,1508 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/delete": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move a poll to the trash. It can be restored until the retention period ends, after which it is deleted permanently together with its ballots.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Polls (Admin)"
+                ],
+                "summary": "Delete poll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Poll identifier",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/sheet/create": {
+        "/api/v1/edit": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Create a new sheet (verified admin or super admin).",
+                ],
+                "description": "Update fields of an existing poll.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Polls (Admin)"
+                ],
+                "summary": "Update poll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Poll identifier",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sheet identifier",
+                        "name": "sheet_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll title",
+                        "name": "title",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Poll options (matrix columns for matrix polls); the \\",
+                        "name": "options",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Matrix row labels (matrix polls)",
+                        "name": "rows",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll type",
+                        "name": "poll_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Poll categories (repeat parameter for multiple values)",
+                        "name": "category",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll description",
+                        "name": "description",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rating scale minimum (rating polls, default 1)",
+                        "name": "scale_min",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rating scale maximum (rating polls, default 5)",
+                        "name": "scale_max",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rating scale step (rating polls, default 1)",
+                        "name": "scale_step",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Label for each scale point (rating and nps polls)",
+                        "name": "scale_labels",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Slide minimum (slide polls, default 0)",
+                        "name": "slide_min",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Slide maximum (slide polls, default 100)",
+                        "name": "slide_max",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Slide step (slide polls, default 1)",
+                        "name": "slide_step",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unit shown next to slide values",
+                        "name": "slide_unit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Indices of the correct options (quiz questions)",
+                        "name": "correct_options",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Points for a correct answer (quiz questions, default 1)",
+                        "name": "points",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Whether the poll must be answered when submitting the sheet",
+                        "name": "required",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Show the options in a per-respondent random order (single choice, multi choice, ranking and matrix polls)",
+                        "name": "shuffle_options",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Append an \\",
+                        "name": "allow_other",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum number of selected options (multi choice polls)",
+                        "name": "min_selections",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of selected options (multi choice polls)",
+                        "name": "max_selections",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/login": {
+            "post": {
+                "description": "Authenticate a user using email and password and receive tokens.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Login user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Registered email address",
+                        "name": "email",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Registered phone number",
+                        "name": "phone",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Password",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/media/{key}": {
+            "get": {
+                "description": "Download an image attached to a poll option. Attachment keys are listed in poll responses.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Polls"
+                ],
+                "summary": "Download option media",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Attachment key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/poll/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve pending notifications (super admin only).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "List pending notifications",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.NotificationListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/poll/notifications/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Approve a pending notification (super admin only).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Approve notification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification identifier",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.NotificationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/poll/notifications/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reject a pending notification (super admin only).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Reject notification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification identifier",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.NotificationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/profile": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the profile for the authenticated user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profile"
+                ],
+                "summary": "Get current user profile",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Profile"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/refresh": {
+            "post": {
+                "description": "Exchange an existing refresh token for a new access/refresh token pair.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Refresh authentication tokens",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Refresh token when cookie is unavailable",
+                        "name": "refreshToken",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.RefreshTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sheet/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new sheet (verified admin or super admin).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sheets"
+                ],
+                "summary": "Create sheet",
+                "parameters": [
+                    {
+                        "description": "Sheet creation payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.SheetCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SheetCreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sheet/delete": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move a sheet and its polls to the trash. Trashed sheets can be restored until the retention period ends, after which they are deleted permanently together with their responses and pending approval notifications.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sheets"
+                ],
+                "summary": "Delete sheet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sheet identifier",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sheet/duplicate/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Copy a sheet (super admin or sheet owner) with all its polls. The copy gets fresh identifiers, empty results and no schedule, and goes through approval like a new sheet. Option images are not copied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sheets"
+                ],
+                "summary": "Duplicate sheet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sheet identifier",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional title and venue for the copy",
+                        "name": "payload",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SheetDuplicateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SheetCreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sheet/export/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download sheet details alongside poll statistics as an Excel workbook. The workbook spreads the sheet over several tabs and is not in the import format.",
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Sheets"
+                ],
+                "summary": "Export sheet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sheet identifier",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sheet/fetch": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve sheets depending on admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sheets"
+                ],
+                "summary": "List sheets",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SheetListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sheet/finish": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark a sheet as finished (super admin or sheet owner).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sheets"
+                ],
+                "summary": "Finish sheet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sheet identifier",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Status payload (status must be 'finished')",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.SheetStatusUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sheet/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a sheet from an uploaded .xlsx or .csv file (up to 2 MB). The first row is a header with the columns title, type, options and categories, plus an optional description; each following row is one poll. Separate multiple options or categories with \"|\". Only the first sheet of a workbook is read, so a sheet export cannot be imported as is. Rows are validated like polls in a sheet creation request and every invalid row is reported in row_errors; the sheet and its polls are only created if all rows are valid.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sheets"
+                ],
+                "summary": "Import sheet",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Workbook (.xlsx, first sheet) or CSV file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sheet title",
+                        "name": "title",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sheet venue",
+                        "name": "venue",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sheet description",
+                        "name": "description",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Require a phone number from respondents",
+                        "name": "is_phone_required",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Grade the sheet as a quiz",
+                        "name": "is_quiz",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Show polls in a per-respondent random order",
+                        "name": "shuffle_polls",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Duplicate protection mode",
+                        "name": "dedup_mode",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opening time (RFC 3339)",
+                        "name": "opens_at",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Closing time (RFC 3339)",
+                        "name": "closes_at",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SheetCreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sheet/restore": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore a trashed sheet (super admin or sheet owner) together with the polls that were deleted with it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sheets"
+                ],
+                "summary": "Restore sheet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sheet identifier",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sheet/template/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a sheet and its polls from a template (verified admin or super admin). Title and venue default to the template values; the schedule is optional. The sheet is validated and approved like any new sheet.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sheets"
+                ],
+                "summary": "Create sheet from template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template identifier",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional overrides for the new sheet",
+                        "name": "payload",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/domain.TemplateInstantiateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SheetCreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sheet/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve trashed sheets, most recently deleted first. Super admins see every sheet, verified admins only their own.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sheets"
+                ],
+                "summary": "List trashed sheets",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SheetListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sheet/{id}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Partially update a sheet (super admin or sheet owner). Title, venue and description can always change. Finished and rejected sheets accept nothing else; once the sheet has responses the phone requirement, dedup mode and opening time are fixed, and a published sheet that is already open keeps its opening time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sheets"
+                ],
+                "summary": "Update sheet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sheet identifier",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.SheetUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Sheet"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/signup": {
+            "post": {
+                "description": "Register a new user and receive access and refresh tokens.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Full name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Phone number",
+                        "name": "phone",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Organization name",
+                        "name": "organization",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Password",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SignupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/submit": {
+            "post": {
+                "description": "Submit votes for a poll. A valid respondent phone is required when the sheet is phone-protected. Quiz sheets require the respondent token issued by the fetch endpoint and return the score once every poll has been answered. A poll with a display rule is only accepted once the respondent's earlier answer shows it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Polls"
+                ],
+                "summary": "Submit poll votes",
+                "parameters": [
+                    {
+                        "description": "Votes payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.PollClientRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PollSubmitResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/submit/sheet": {
+            "post": {
+                "description": "Submit answers for multiple polls of a sheet in one request. Either every answer is recorded or none is.",
                 "consumes": [
                     "application/json"
                 ],
@@ -825,25 +2490,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Sheets"
+                    "Polls"
                 ],
-                "summary": "Create sheet",
+                "summary": "Submit sheet answers",
                 "parameters": [
                     {
-                        "description": "Sheet creation payload",
+                        "description": "Sheet answers payload",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.SheetCreateRequest"
+                            "$ref": "#/definitions/domain.PollClientSheetRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.SheetCreateResponse"
+                            "$ref": "#/definitions/domain.PollSubmitResponse"
                         }
                     },
                     "400": {
@@ -852,8 +2517,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
@@ -867,35 +2544,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/sheet/delete": {
-            "put": {
+        "/api/v1/template/create": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a sheet by identifier.",
+                "description": "Publish a named sheet template (super admin only). The sheet payload is validated like a sheet creation request and may not set opens_at or closes_at.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sheets"
+                    "Templates"
                 ],
-                "summary": "Delete sheet",
+                "summary": "Publish template",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Sheet identifier",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "Template payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.TemplateRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/domain.SuccessResponse"
+                            "$ref": "#/definitions/domain.Template"
                         }
                     },
                     "400": {
@@ -910,12 +2592,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/domain.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -925,27 +2601,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/sheet/export/{id}": {
-            "get": {
+        "/api/v1/template/delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Download sheet details alongside poll statistics as an Excel workbook.",
+                "description": "Delete a template (super admin only).",
                 "produces": [
-                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                    "application/json"
                 ],
                 "tags": [
-                    "Sheets"
+                    "Templates"
                 ],
-                "summary": "Export sheet",
+                "summary": "Delete template",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Sheet identifier",
+                        "description": "Template identifier",
                         "name": "id",
-                        "in": "path",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -953,7 +2629,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "file"
+                            "$ref": "#/definitions/domain.SuccessResponse"
                         }
                     },
                     "400": {
@@ -983,22 +2659,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/sheet/fetch": {
+        "/api/v1/template/fetch": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve sheets depending on admin role.",
+                "description": "Browse templates by name, optionally filtered by category and tag (verified admin or super admin).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sheets"
+                    "Templates"
                 ],
-                "summary": "List sheets",
+                "summary": "List templates",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "Page number",
@@ -1016,7 +2704,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.SheetListResponse"
+                            "$ref": "#/definitions/domain.TemplateListResponse"
                         }
                     },
                     "401": {
@@ -1034,53 +2722,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/sheet/finish": {
-            "put": {
+        "/api/v1/template/fetch/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mark a sheet as finished (super admin or sheet owner).",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieve a template with its sheet payload (verified admin or super admin).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sheets"
+                    "Templates"
                 ],
-                "summary": "Finish sheet",
+                "summary": "Get template",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Sheet identifier",
+                        "description": "Template identifier",
                         "name": "id",
-                        "in": "query",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Status payload (status must be 'finished')",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/domain.SheetStatusUpdateRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.SuccessResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/domain.ErrorResponse"
+                            "$ref": "#/definitions/domain.Template"
                         }
                     },
                     "401": {
@@ -1104,54 +2774,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/signup": {
-            "post": {
-                "description": "Register a new user and receive access and refresh tokens.",
+        "/api/v1/template/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace a template (super admin only). Each update increases the template version.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Templates"
                 ],
-                "summary": "Register a new user",
+                "summary": "Update template",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Full name",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Phone number",
-                        "name": "phone",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Organization name",
-                        "name": "organization",
-                        "in": "formData",
+                        "description": "Template identifier",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Password",
-                        "name": "password",
-                        "in": "formData",
-                        "required": true
+                        "description": "Template payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.TemplateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.SignupResponse"
+                            "$ref": "#/definitions/domain.Template"
                         }
                     },
                     "400": {
@@ -1160,48 +2823,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/api/v1/submit": {
-            "post": {
-                "description": "Submit votes for a poll.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Polls"
-                ],
-                "summary": "Submit poll votes",
-                "parameters": [
-                    {
-                        "description": "Votes payload",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/domain.PollClientRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/domain.SuccessResponse"
+                            "$ref": "#/definitions/domain.ErrorResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/domain.ErrorResponse"
                         }
@@ -1214,25 +2849,152 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "domain.AdminRequest": {
+        }
+    },
+    "definitions": {
+        "domain.AdminRequest": {
+            "type": "object",
+            "properties": {
+                "is_verified": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.BallotClient": {
+            "type": "object",
+            "properties": {
+                "ip": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.BallotListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.BallotResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/domain.PaginationResult"
+                }
+            }
+        },
+        "domain.BallotResponse": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "$ref": "#/definitions/domain.BallotClient"
+                },
+                "correct": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "poll_id": {
+                    "type": "string"
+                },
+                "respondent_key": {
+                    "type": "string"
+                },
+                "sheet_id": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.DedupMode": {
+            "type": "string",
+            "enum": [
+                "none",
+                "phone",
+                "token",
+                "fingerprint"
+            ],
+            "x-enum-varnames": [
+                "DedupNone",
+                "DedupPhone",
+                "DedupToken",
+                "DedupFingerprint"
+            ]
+        },
+        "domain.DisplayRule": {
             "type": "object",
             "properties": {
-                "is_verified": {
-                    "type": "boolean"
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
-                "user_id": {
+                "poll_id": {
                     "type": "string"
                 }
             }
         },
         "domain.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "missing_poll_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "row_errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ImportRowError"
+                    }
+                }
+            }
+        },
+        "domain.ImportRowError": {
             "type": "object",
             "properties": {
                 "message": {
                     "type": "string"
+                },
+                "row": {
+                    "type": "integer"
                 }
             }
         },
@@ -1332,6 +3094,26 @@ const docTemplate = `{
                 "NotificationTypeSheetApproval"
             ]
         },
+        "domain.OptionMedia": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "option": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
         "domain.PaginationResult": {
             "type": "object",
             "properties": {
@@ -1358,6 +3140,12 @@ const docTemplate = `{
                         "$ref": "#/definitions/domain.PollAdminResponse"
                     }
                 },
+                "leaderboard": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.QuizLeaderboardEntry"
+                    }
+                },
                 "pagination": {
                     "$ref": "#/definitions/domain.PaginationResult"
                 }
@@ -1366,36 +3154,114 @@ const docTemplate = `{
         "domain.PollAdminResponse": {
             "type": "object",
             "properties": {
+                "allow_other": {
+                    "type": "boolean"
+                },
                 "category": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "correct_options": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "correct_rate": {
+                    "type": "number"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "matrix_votes": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "max_selections": {
+                    "type": "integer"
+                },
+                "min_selections": {
+                    "type": "integer"
+                },
+                "nps": {
+                    "$ref": "#/definitions/domain.PollNPSBreakdown"
+                },
+                "option_media": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OptionMedia"
+                    }
+                },
                 "options": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "other_responses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "participant": {
                     "type": "integer"
                 },
+                "points": {
+                    "type": "integer"
+                },
                 "poll_type": {
                     "$ref": "#/definitions/domain.PollType"
                 },
+                "position": {
+                    "type": "integer"
+                },
+                "ranking": {
+                    "$ref": "#/definitions/domain.PollRankingResponse"
+                },
+                "required": {
+                    "type": "boolean"
+                },
                 "responses": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scale": {
+                    "$ref": "#/definitions/domain.ScaleConfig"
+                },
+                "show_if": {
+                    "$ref": "#/definitions/domain.DisplayRule"
+                },
+                "shuffle_options": {
+                    "type": "boolean"
+                },
+                "slide": {
+                    "$ref": "#/definitions/domain.SlideConfig"
+                },
+                "stats": {
+                    "$ref": "#/definitions/domain.PollNumericStats"
+                },
                 "title": {
                     "type": "string"
                 },
@@ -1407,6 +3273,38 @@ const docTemplate = `{
                 }
             }
         },
+        "domain.PollClientAnswer": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "inputs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "matrix": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "value": {
+                    "type": "number"
+                },
+                "votes": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
         "domain.PollClientListResponse": {
             "type": "object",
             "properties": {
@@ -1436,6 +3334,24 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "matrix": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "respondent_token": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                },
                 "votes": {
                     "type": "array",
                     "items": {
@@ -1447,12 +3363,27 @@ const docTemplate = `{
         "domain.PollClientResponse": {
             "type": "object",
             "properties": {
+                "allow_other": {
+                    "type": "boolean"
+                },
                 "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "max_selections": {
+                    "type": "integer"
+                },
+                "min_selections": {
+                    "type": "integer"
+                },
+                "option_media": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "options": {
                     "type": "array",
                     "items": {
@@ -1462,6 +3393,24 @@ const docTemplate = `{
                 "poll_type": {
                     "$ref": "#/definitions/domain.PollType"
                 },
+                "required": {
+                    "type": "boolean"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scale": {
+                    "$ref": "#/definitions/domain.ScaleConfig"
+                },
+                "show_if": {
+                    "$ref": "#/definitions/domain.DisplayRule"
+                },
+                "slide": {
+                    "$ref": "#/definitions/domain.SlideConfig"
+                },
                 "title": {
                     "type": "string"
                 }
@@ -1470,30 +3419,158 @@ const docTemplate = `{
         "domain.PollClientSheetMeta": {
             "type": "object",
             "properties": {
+                "dedup_mode": {
+                    "$ref": "#/definitions/domain.DedupMode"
+                },
                 "id": {
                     "type": "string"
                 },
                 "is_phone_required": {
                     "type": "boolean"
                 },
+                "is_quiz": {
+                    "type": "boolean"
+                },
+                "respondent_token": {
+                    "type": "string"
+                },
                 "title": {
                     "type": "string"
                 }
             }
         },
+        "domain.PollClientSheetRequest": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PollClientAnswer"
+                    }
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "respondent_token": {
+                    "type": "string"
+                },
+                "sheet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.PollNPSBreakdown": {
+            "type": "object",
+            "properties": {
+                "detractors": {
+                    "type": "integer"
+                },
+                "passives": {
+                    "type": "integer"
+                },
+                "promoters": {
+                    "type": "integer"
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.PollNumericStats": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "mean": {
+                    "type": "number"
+                },
+                "median": {
+                    "type": "number"
+                },
+                "std_dev": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.PollRankingResponse": {
+            "type": "object",
+            "properties": {
+                "average_positions": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "borda_scores": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "domain.PollReorderRequest": {
+            "type": "object",
+            "required": [
+                "poll_ids",
+                "sheet_id"
+            ],
+            "properties": {
+                "poll_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sheet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.PollSubmitResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "quiz": {
+                    "$ref": "#/definitions/domain.QuizScore"
+                }
+            }
+        },
+        "domain.PollTrashResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PollAdminResponse"
+                    }
+                }
+            }
+        },
         "domain.PollType": {
             "type": "string",
             "enum": [
                 "single_choice",
                 "multi_choice",
                 "slide",
-                "opinion"
+                "opinion",
+                "ranking",
+                "rating",
+                "nps",
+                "matrix"
             ],
             "x-enum-varnames": [
                 "singleChoice",
                 "multiChoice",
                 "slide",
-                "opinion"
+                "opinion",
+                "ranking",
+                "rating",
+                "nps",
+                "matrix"
             ]
         },
         "domain.Profile": {
@@ -1510,6 +3587,49 @@ const docTemplate = `{
                 }
             }
         },
+        "domain.QuizLeaderboardEntry": {
+            "type": "object",
+            "properties": {
+                "answered": {
+                    "type": "integer"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "correct": {
+                    "type": "integer"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "rank": {
+                    "type": "integer"
+                },
+                "respondent_key": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.QuizScore": {
+            "type": "object",
+            "properties": {
+                "correct": {
+                    "type": "integer"
+                },
+                "max_score": {
+                    "type": "integer"
+                },
+                "questions": {
+                    "type": "integer"
+                },
+                "score": {
+                    "type": "integer"
+                }
+            }
+        },
         "domain.RefreshTokenResponse": {
             "type": "object",
             "properties": {
@@ -1521,6 +3641,26 @@ const docTemplate = `{
                 }
             }
         },
+        "domain.ScaleConfig": {
+            "type": "object",
+            "properties": {
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "max": {
+                    "type": "integer"
+                },
+                "min": {
+                    "type": "integer"
+                },
+                "step": {
+                    "type": "integer"
+                }
+            }
+        },
         "domain.Sheet": {
             "type": "object",
             "required": [
@@ -1534,6 +3674,27 @@ const docTemplate = `{
                 "approved_by": {
                     "type": "string"
                 },
+                "close_reason": {
+                    "$ref": "#/definitions/domain.SheetCloseReason"
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "closed_by": {
+                    "type": "string"
+                },
+                "closes_at": {
+                    "type": "string"
+                },
+                "dedup_mode": {
+                    "$ref": "#/definitions/domain.DedupMode"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
@@ -1543,6 +3704,15 @@ const docTemplate = `{
                 "is_phone_required": {
                     "type": "boolean"
                 },
+                "is_quiz": {
+                    "type": "boolean"
+                },
+                "opens_at": {
+                    "type": "string"
+                },
+                "shuffle_polls": {
+                    "type": "boolean"
+                },
                 "status": {
                     "$ref": "#/definitions/domain.SheetStatus"
                 },
@@ -1554,27 +3724,77 @@ const docTemplate = `{
                 }
             }
         },
+        "domain.SheetCloseReason": {
+            "type": "string",
+            "enum": [
+                "manual",
+                "schedule"
+            ],
+            "x-enum-varnames": [
+                "SheetClosedManually",
+                "SheetClosedBySchedule"
+            ]
+        },
         "domain.SheetCreatePoll": {
             "type": "object",
             "properties": {
+                "allow_other": {
+                    "type": "boolean"
+                },
                 "category": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "correct_options": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "description": {
                     "type": "string"
                 },
+                "max_selections": {
+                    "type": "integer"
+                },
+                "min_selections": {
+                    "type": "integer"
+                },
                 "options": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "points": {
+                    "type": "integer"
+                },
                 "poll_type": {
                     "type": "string"
                 },
+                "required": {
+                    "type": "boolean"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scale": {
+                    "$ref": "#/definitions/domain.ScaleConfig"
+                },
+                "show_if": {
+                    "$ref": "#/definitions/domain.SheetDisplayRule"
+                },
+                "shuffle_options": {
+                    "type": "boolean"
+                },
+                "slide": {
+                    "$ref": "#/definitions/domain.SlideConfig"
+                },
                 "title": {
                     "type": "string"
                 }
@@ -1583,15 +3803,33 @@ const docTemplate = `{
         "domain.SheetCreateRequest": {
             "type": "object",
             "properties": {
+                "closes_at": {
+                    "type": "string"
+                },
+                "dedup_mode": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
                 "is_phone_required": {
                     "type": "boolean"
                 },
+                "is_quiz": {
+                    "type": "boolean"
+                },
+                "opens_at": {
+                    "type": "string"
+                },
                 "polls": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/domain.SheetCreatePoll"
                     }
                 },
+                "shuffle_polls": {
+                    "type": "boolean"
+                },
                 "title": {
                     "type": "string"
                 },
@@ -1617,9 +3855,37 @@ const docTemplate = `{
                 }
             }
         },
+        "domain.SheetDisplayRule": {
+            "type": "object",
+            "properties": {
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "poll": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.SheetDuplicateRequest": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string"
+                },
+                "venue": {
+                    "type": "string"
+                }
+            }
+        },
         "domain.SheetListItem": {
             "type": "object",
             "properties": {
+                "deleted_at": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -1677,6 +3943,35 @@ const docTemplate = `{
                 }
             }
         },
+        "domain.SheetUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "closes_at": {
+                    "type": "string"
+                },
+                "dedup_mode": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "is_phone_required": {
+                    "type": "boolean"
+                },
+                "opens_at": {
+                    "type": "string"
+                },
+                "shuffle_polls": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "venue": {
+                    "type": "string"
+                }
+            }
+        },
         "domain.SignupResponse": {
             "type": "object",
             "properties": {
@@ -1688,6 +3983,23 @@ const docTemplate = `{
                 }
             }
         },
+        "domain.SlideConfig": {
+            "type": "object",
+            "properties": {
+                "max": {
+                    "type": "number"
+                },
+                "min": {
+                    "type": "number"
+                },
+                "step": {
+                    "type": "number"
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
         "domain.SuccessResponse": {
             "type": "object",
             "properties": {
@@ -1696,6 +4008,101 @@ const docTemplate = `{
                 }
             }
         },
+        "domain.Template": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sheet": {
+                    "$ref": "#/definitions/domain.SheetCreateRequest"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.TemplateInstantiateRequest": {
+            "type": "object",
+            "properties": {
+                "closes_at": {
+                    "type": "string"
+                },
+                "opens_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "venue": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.TemplateListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Template"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/domain.PaginationResult"
+                }
+            }
+        },
+        "domain.TemplateRequest": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sheet": {
+                    "$ref": "#/definitions/domain.SheetCreateRequest"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "domain.UserListItem": {
             "type": "object",
             "properties": {
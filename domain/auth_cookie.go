@@ -3,4 +3,5 @@ package domain
 const (
 	AccessTokenCookieName  = "access_token"
 	RefreshTokenCookieName = "refresh_token"
+	RespondentCookieName   = "respondent_token"
 )
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const CollectionBallot = "ballots"
+
+var (
+	ErrBallotNotFound   = errors.New("ballot not found")
+	ErrPollHasResponses = errors.New("poll type, options, rows and answer key cannot change once the poll has responses")
+	ErrPollEditConflict = errors.New("poll received responses while it was being edited")
+)
+
+type BallotClient struct {
+	IP        string `bson:"ip,omitempty" json:"ip,omitempty"`
+	UserAgent string `bson:"userAgent,omitempty" json:"user_agent,omitempty"`
+}
+
+// Ballot is a single respondent's answer to a poll. Poll vote counters are
+// aggregates of the stored ballots and can be rebuilt from them at any time.
+type Ballot struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	SheetID       primitive.ObjectID `bson:"sheetID"`
+	PollID        primitive.ObjectID `bson:"pollID"`
+	RespondentKey string             `bson:"respondentKey"`
+	Unique        bool               `bson:"unique,omitempty"` // set when the sheet allows one ballot per respondent and poll
+	Phone         string             `bson:"phone,omitempty"`
+	Options       []int              `bson:"options,omitempty"` // selected indices, the preference order for ranking polls, or the column per row for matrix polls
+	Text          []string           `bson:"text,omitempty"`
+	Value         *float64           `bson:"value,omitempty"`
+	Correct       *bool              `bson:"correct,omitempty"`
+	Points        int                `bson:"points,omitempty"`
+	Client        BallotClient       `bson:"client"`
+	CreatedAt     time.Time          `bson:"createdAt"`
+}
+
+type BallotRepository interface {
+	Create(ctx context.Context, ballot *Ballot) error
+	GetByID(ctx context.Context, id string) (Ballot, error)
+	GetByPollID(ctx context.Context, pollID string, pagination PaginationQuery) ([]Ballot, int64, error)
+	GetByPollIDs(ctx context.Context, pollIDs []string) ([]Ballot, error)
+	GetByRespondent(ctx context.Context, sheetID string, respondentKey string) ([]Ballot, error)
+	Delete(ctx context.Context, id string) error
+	EnsureIndexes(ctx context.Context) error
+	CountByPollIDs(ctx context.Context, pollIDs []string) (int64, error)
+	DeleteBySheetID(ctx context.Context, sheetID string) (int64, error)
+	DeleteByPollID(ctx context.Context, pollID string) (int64, error)
+	GetValuesByPollIDs(ctx context.Context, pollIDs []string) (map[string][]float64, error)
+}
+
+type BallotResponse struct {
+	ID            string       `json:"id"`
+	SheetID       string       `json:"sheet_id"`
+	PollID        string       `json:"poll_id"`
+	RespondentKey string       `json:"respondent_key"`
+	Phone         string       `json:"phone,omitempty"`
+	Options       []int        `json:"options,omitempty"`
+	Text          []string     `json:"text,omitempty"`
+	Value         *float64     `json:"value,omitempty"`
+	Correct       *bool        `json:"correct,omitempty"`
+	Points        int          `json:"points,omitempty"`
+	Client        BallotClient `json:"client"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+// ResetAggregates clears the derived vote counters so they can be rebuilt from ballots.
+func (p *Poll) ResetAggregates() {
+	p.Participant = 0
+	p.CorrectCount = 0
+	if p.PollType == PollTypeOpinion {
+		p.Votes = nil
+		p.Responses = []string{}
+		return
+	}
+
+	p.Votes = make([]int, p.PollType.VoteSlots(len(p.Options)))
+	p.Responses = nil
+	p.Ranking = nil
+	p.SlideValues = nil
+	p.MatrixVotes = nil
+	p.OtherResponses = nil
+	switch p.PollType {
+	case PollTypeRanking:
+		p.Ranking = newRankingAggregate(len(p.Options))
+	case PollTypeMatrix:
+		p.MatrixVotes = newMatrixVotes(len(p.Rows), len(p.Options))
+	}
+}
+
+// SameShape reports whether other has the type, options, rows and answer key of p, so
+// that ballots cast and graded against one remain valid for the other.
+func (p Poll) SameShape(other Poll) bool {
+	return p.PollType == other.PollType && equalLabels(p.Options, other.Options) && equalLabels(p.Rows, other.Rows) &&
+		equalIndices(p.CorrectOptions, other.CorrectOptions) && p.Points == other.Points
+}
+
+// CopyAggregates takes over the derived vote counters of from.
+func (p *Poll) CopyAggregates(from Poll) {
+	p.Participant = from.Participant
+	p.CorrectCount = from.CorrectCount
+	p.Votes = from.Votes
+	p.Responses = from.Responses
+	p.Ranking = from.Ranking
+	p.SlideValues = from.SlideValues
+	p.MatrixVotes = from.MatrixVotes
+	p.OtherResponses = from.OtherResponses
+}
+
+func equalLabels(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for idx := range a {
+		if a[idx] != b[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalIndices(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for idx := range a {
+		if a[idx] != b[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyBallot folds a single ballot into the poll's derived aggregates.
+func (p *Poll) ApplyBallot(ballot Ballot) {
+	p.Participant++
+	if ballot.Correct != nil && *ballot.Correct {
+		p.CorrectCount++
+	}
+	if p.PollType == PollTypeRanking {
+		p.applyRanking(ballot.Options)
+		return
+	}
+	if p.PollType == PollTypeMatrix {
+		p.applyMatrix(ballot.Options)
+		return
+	}
+	if p.PollType == PollTypeSlide && p.Slide != nil && ballot.Value != nil {
+		if bucket := p.Slide.Bucket(*ballot.Value); bucket < len(p.Votes) {
+			p.Votes[bucket]++
+		}
+		p.SlideValues = append(p.SlideValues, *ballot.Value)
+		return
+	}
+	for _, option := range ballot.Options {
+		if option >= 0 && option < len(p.Votes) {
+			p.Votes[option]++
+		}
+	}
+	if len(ballot.Text) > 0 {
+		if p.AllowOther {
+			p.OtherResponses = append(p.OtherResponses, ballot.Text...)
+		} else {
+			p.Responses = append(p.Responses, ballot.Text...)
+		}
+	}
+}
@@ -2,4 +2,8 @@ package domain
 
 type ErrorResponse struct {
 	Message string `json:"message"`
+	Code    string `json:"code,omitempty"`
+
+	MissingPollIDs []string         `json:"missing_poll_ids,omitempty"`
+	RowErrors      []ImportRowError `json:"row_errors,omitempty"`
 }
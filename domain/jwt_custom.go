@@ -15,3 +15,8 @@ type JwtCustomRefreshClaims struct {
 	ID string `json:"id"`
 	jwt.StandardClaims
 }
+
+type JwtRespondentClaims struct {
+	ID string `json:"id"`
+	jwt.StandardClaims
+}
@@ -9,6 +9,7 @@ type SheetListItem struct {
 	Venue     string      `json:"venue"`
 	Status    SheetStatus `json:"status"`
 	UpdatedAt time.Time   `json:"updated_at"`
+	DeletedAt *time.Time  `json:"deleted_at,omitempty"`
 }
 
 type SheetListResponse struct {
@@ -21,9 +22,19 @@ type NotificationListResponse struct {
 	Pagination PaginationResult       `json:"pagination"`
 }
 
+type PollTrashResponse struct {
+	Data []PollAdminResponse `json:"data"`
+}
+
 type PollAdminListResponse struct {
-	Data       []PollAdminResponse `json:"data"`
-	Pagination PaginationResult    `json:"pagination"`
+	Data        []PollAdminResponse    `json:"data"`
+	Leaderboard []QuizLeaderboardEntry `json:"leaderboard,omitempty"`
+	Pagination  PaginationResult       `json:"pagination"`
+}
+
+type BallotListResponse struct {
+	Data       []BallotResponse `json:"data"`
+	Pagination PaginationResult `json:"pagination"`
 }
 
 type PollClientListResponse struct {
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"io"
+	"regexp"
+)
+
+// MaxMediaSize caps the size of an uploaded option attachment.
+const MaxMediaSize = 5 << 20
+
+// MediaRoutePrefix is where option attachments are served from.
+const MediaRoutePrefix = "/api/v1/media/"
+
+var (
+	ErrMediaNotFound        = errors.New("media not found")
+	ErrMediaTooLarge        = errors.New("media exceeds the 5 MB upload limit")
+	ErrMediaConflict        = errors.New("option media was changed concurrently, try again")
+	ErrUnsupportedMediaType = errors.New("media must be a PNG, JPEG, GIF or WebP image")
+	ErrInvalidMediaOption   = errors.New("media option does not exist on the poll")
+)
+
+// mediaExtensions maps the accepted image content types to the extension used in storage keys.
+var mediaExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+var mediaKeyPattern = regexp.MustCompile(`^[0-9a-f]{24}\.(png|jpg|gif|webp)$`)
+
+// OptionMedia is an image attached to one option of a poll.
+type OptionMedia struct {
+	Option      int    `bson:"option" json:"option"`
+	Key         string `bson:"key" json:"key"`
+	FileName    string `bson:"fileName" json:"file_name"`
+	ContentType string `bson:"contentType" json:"content_type"`
+	Size        int64  `bson:"size" json:"size"`
+}
+
+// MediaUpload is an attachment received from an admin before it is stored.
+type MediaUpload struct {
+	FileName string
+	Size     int64
+	Content  io.Reader
+}
+
+// BlobStore persists attachment content by key. Implementations must reject keys
+// that do not satisfy ValidMediaKey.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type MediaUsecase interface {
+	GetPollSheet(c context.Context, pollID string) (Sheet, error)
+	UploadOptionMedia(c context.Context, pollID string, option int, upload MediaUpload) (Poll, error)
+	RemoveOptionMedia(c context.Context, pollID string, option int) (Poll, error)
+	Open(c context.Context, key string) (io.ReadCloser, error)
+}
+
+// MediaExtension returns the storage extension for an accepted content type.
+func MediaExtension(contentType string) (string, bool) {
+	extension, ok := mediaExtensions[contentType]
+	return extension, ok
+}
+
+// ValidMediaKey reports whether key has the shape of a generated storage key.
+func ValidMediaKey(key string) bool {
+	return mediaKeyPattern.MatchString(key)
+}
+
+// MediaURL returns the download path of a stored attachment.
+func MediaURL(key string) string {
+	return MediaRoutePrefix + key
+}
+
+// OptionMediaURLs returns the attachment URL of every option in canonical order, with
+// blanks for options without one, or nil when the poll has no attachments.
+func (p Poll) OptionMediaURLs() []string {
+	if len(p.OptionMedia) == 0 {
+		return nil
+	}
+
+	urls := make([]string, len(p.Options))
+	for _, media := range p.OptionMedia {
+		if media.Option >= 0 && media.Option < len(urls) {
+			urls[media.Option] = MediaURL(media.Key)
+		}
+	}
+	return urls
+}
+
+// WithOptionMedia returns the poll's attachments with media set for its option,
+// together with the attachment it replaces, if any.
+func (p Poll) WithOptionMedia(media OptionMedia) ([]OptionMedia, *OptionMedia) {
+	updated := make([]OptionMedia, 0, len(p.OptionMedia)+1)
+	var replaced *OptionMedia
+	for _, existing := range p.OptionMedia {
+		if existing.Option == media.Option {
+			previous := existing
+			replaced = &previous
+			continue
+		}
+		updated = append(updated, existing)
+	}
+	return append(updated, media), replaced
+}
+
+// RemapOptionMedia moves attachments along when a poll's options change from one list of
+// labels to another: each attachment follows its option's label to its new index. The
+// attachments of options that no longer exist are returned as dropped.
+func RemapOptionMedia(media []OptionMedia, from, to []string) ([]OptionMedia, []OptionMedia) {
+	// Repeated labels are matched by their order of appearance.
+	positions := make(map[string][]int, len(to))
+	for idx, label := range to {
+		positions[label] = append(positions[label], idx)
+	}
+
+	kept := make([]OptionMedia, 0, len(media))
+	var dropped []OptionMedia
+	for _, attachment := range media {
+		if attachment.Option < 0 || attachment.Option >= len(from) {
+			dropped = append(dropped, attachment)
+			continue
+		}
+
+		label := from[attachment.Option]
+		occurrence := 0
+		for _, previous := range from[:attachment.Option] {
+			if previous == label {
+				occurrence++
+			}
+		}
+
+		if occurrence >= len(positions[label]) {
+			dropped = append(dropped, attachment)
+			continue
+		}
+		attachment.Option = positions[label][occurrence]
+		kept = append(kept, attachment)
+	}
+
+	return kept, dropped
+}
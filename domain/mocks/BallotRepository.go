@@ -0,0 +1,322 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BallotRepository is an autogenerated mock type for the BallotRepository type
+type BallotRepository struct {
+	mock.Mock
+}
+
+// CountByPollIDs provides a mock function with given fields: ctx, pollIDs
+func (_m *BallotRepository) CountByPollIDs(ctx context.Context, pollIDs []string) (int64, error) {
+	ret := _m.Called(ctx, pollIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByPollIDs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (int64, error)); ok {
+		return rf(ctx, pollIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) int64); ok {
+		r0 = rf(ctx, pollIDs)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, pollIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, ballot
+func (_m *BallotRepository) Create(ctx context.Context, ballot *domain.Ballot) error {
+	ret := _m.Called(ctx, ballot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Ballot) error); ok {
+		r0 = rf(ctx, ballot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *BallotRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByPollID provides a mock function with given fields: ctx, pollID
+func (_m *BallotRepository) DeleteByPollID(ctx context.Context, pollID string) (int64, error) {
+	ret := _m.Called(ctx, pollID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByPollID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, pollID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, pollID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, pollID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteBySheetID provides a mock function with given fields: ctx, sheetID
+func (_m *BallotRepository) DeleteBySheetID(ctx context.Context, sheetID string) (int64, error) {
+	ret := _m.Called(ctx, sheetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBySheetID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, sheetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, sheetID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sheetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *BallotRepository) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureIndexes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *BallotRepository) GetByID(ctx context.Context, id string) (domain.Ballot, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Ballot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Ballot, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Ballot); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Ballot)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByPollID provides a mock function with given fields: ctx, pollID, pagination
+func (_m *BallotRepository) GetByPollID(ctx context.Context, pollID string, pagination domain.PaginationQuery) ([]domain.Ballot, int64, error) {
+	ret := _m.Called(ctx, pollID, pagination)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPollID")
+	}
+
+	var r0 []domain.Ballot
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) ([]domain.Ballot, int64, error)); ok {
+		return rf(ctx, pollID, pagination)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) []domain.Ballot); ok {
+		r0 = rf(ctx, pollID, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Ballot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
+		r1 = rf(ctx, pollID, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
+		r2 = rf(ctx, pollID, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByPollIDs provides a mock function with given fields: ctx, pollIDs
+func (_m *BallotRepository) GetByPollIDs(ctx context.Context, pollIDs []string) ([]domain.Ballot, error) {
+	ret := _m.Called(ctx, pollIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPollIDs")
+	}
+
+	var r0 []domain.Ballot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]domain.Ballot, error)); ok {
+		return rf(ctx, pollIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []domain.Ballot); ok {
+		r0 = rf(ctx, pollIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Ballot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, pollIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByRespondent provides a mock function with given fields: ctx, sheetID, respondentKey
+func (_m *BallotRepository) GetByRespondent(ctx context.Context, sheetID string, respondentKey string) ([]domain.Ballot, error) {
+	ret := _m.Called(ctx, sheetID, respondentKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByRespondent")
+	}
+
+	var r0 []domain.Ballot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]domain.Ballot, error)); ok {
+		return rf(ctx, sheetID, respondentKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []domain.Ballot); ok {
+		r0 = rf(ctx, sheetID, respondentKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Ballot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, sheetID, respondentKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetValuesByPollIDs provides a mock function with given fields: ctx, pollIDs
+func (_m *BallotRepository) GetValuesByPollIDs(ctx context.Context, pollIDs []string) (map[string][]float64, error) {
+	ret := _m.Called(ctx, pollIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetValuesByPollIDs")
+	}
+
+	var r0 map[string][]float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string][]float64, error)); ok {
+		return rf(ctx, pollIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string][]float64); ok {
+		r0 = rf(ctx, pollIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]float64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, pollIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewBallotRepository creates a new instance of BallotRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBallotRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BallotRepository {
+	mock := &BallotRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,145 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	domain "github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MediaUsecase is an autogenerated mock type for the MediaUsecase type
+type MediaUsecase struct {
+	mock.Mock
+}
+
+// GetPollSheet provides a mock function with given fields: c, pollID
+func (_m *MediaUsecase) GetPollSheet(c context.Context, pollID string) (domain.Sheet, error) {
+	ret := _m.Called(c, pollID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPollSheet")
+	}
+
+	var r0 domain.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Sheet, error)); ok {
+		return rf(c, pollID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Sheet); ok {
+		r0 = rf(c, pollID)
+	} else {
+		r0 = ret.Get(0).(domain.Sheet)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, pollID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Open provides a mock function with given fields: c, key
+func (_m *MediaUsecase) Open(c context.Context, key string) (io.ReadCloser, error) {
+	ret := _m.Called(c, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (io.ReadCloser, error)); ok {
+		return rf(c, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) io.ReadCloser); ok {
+		r0 = rf(c, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveOptionMedia provides a mock function with given fields: c, pollID, option
+func (_m *MediaUsecase) RemoveOptionMedia(c context.Context, pollID string, option int) (domain.Poll, error) {
+	ret := _m.Called(c, pollID, option)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveOptionMedia")
+	}
+
+	var r0 domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (domain.Poll, error)); ok {
+		return rf(c, pollID, option)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) domain.Poll); ok {
+		r0 = rf(c, pollID, option)
+	} else {
+		r0 = ret.Get(0).(domain.Poll)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(c, pollID, option)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadOptionMedia provides a mock function with given fields: c, pollID, option, upload
+func (_m *MediaUsecase) UploadOptionMedia(c context.Context, pollID string, option int, upload domain.MediaUpload) (domain.Poll, error) {
+	ret := _m.Called(c, pollID, option, upload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadOptionMedia")
+	}
+
+	var r0 domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, domain.MediaUpload) (domain.Poll, error)); ok {
+		return rf(c, pollID, option, upload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, domain.MediaUpload) domain.Poll); ok {
+		r0 = rf(c, pollID, option, upload)
+	} else {
+		r0 = ret.Get(0).(domain.Poll)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, domain.MediaUpload) error); ok {
+		r1 = rf(c, pollID, option, upload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMediaUsecase creates a new instance of MediaUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMediaUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MediaUsecase {
+	mock := &MediaUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.4. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -54,67 +54,69 @@ func (_m *NotificationRepository) Delete(ctx context.Context, id string) error {
 	return r0
 }
 
+// DeletePendingBySheetID provides a mock function with given fields: ctx, sheetID
+func (_m *NotificationRepository) DeletePendingBySheetID(ctx context.Context, sheetID string) (int64, error) {
+	ret := _m.Called(ctx, sheetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePendingBySheetID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, sheetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, sheetID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sheetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FetchPending provides a mock function with given fields: ctx, pagination
 func (_m *NotificationRepository) FetchPending(ctx context.Context, pagination domain.PaginationQuery) ([]domain.Notification, int64, error) {
-
 	ret := _m.Called(ctx, pagination)
 
 	if len(ret) == 0 {
-
 		panic("no return value specified for FetchPending")
-
 	}
 
 	var r0 []domain.Notification
-
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PaginationQuery) ([]domain.Notification, int64, error)); ok {
+		return rf(ctx, pagination)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, domain.PaginationQuery) []domain.Notification); ok {
-
 		r0 = rf(ctx, pagination)
-
 	} else {
-
 		if ret.Get(0) != nil {
-
 			r0 = ret.Get(0).([]domain.Notification)
-
 		}
-
 	}
 
-	var r1 int64
-
-	if len(ret) > 1 {
-
-		if rf, ok := ret.Get(1).(func(context.Context, domain.PaginationQuery) int64); ok {
-
-			r1 = rf(ctx, pagination)
-
-		} else if ret.Get(1) != nil {
-
-			r1 = ret.Get(1).(int64)
-
-		}
-
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PaginationQuery) int64); ok {
+		r1 = rf(ctx, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
 	}
 
-	var r2 error
-
-	if len(ret) > 2 {
-
-		if rf, ok := ret.Get(2).(func(context.Context, domain.PaginationQuery) error); ok {
-
-			r2 = rf(ctx, pagination)
-
-		} else {
-
-			r2 = ret.Error(2)
-
-		}
-
+	if rf, ok := ret.Get(2).(func(context.Context, domain.PaginationQuery) error); ok {
+		r2 = rf(ctx, pagination)
+	} else {
+		r2 = ret.Error(2)
 	}
 
 	return r0, r1, r2
-
 }
 
 // GetByID provides a mock function with given fields: ctx, id
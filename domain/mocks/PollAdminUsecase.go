@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.4. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -7,6 +7,10 @@ import (
 
 	domain "github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+
+	time "time"
 )
 
 // PollAdminUsecase is an autogenerated mock type for the PollAdminUsecase type
@@ -32,17 +36,17 @@ func (_m *PollAdminUsecase) CreatePoll(c context.Context, poll *domain.Poll) err
 	return r0
 }
 
-// Delete provides a mock function with given fields: c, id
-func (_m *PollAdminUsecase) Delete(c context.Context, id string) error {
-	ret := _m.Called(c, id)
+// Delete provides a mock function with given fields: c, id, deletedBy, deletedAt
+func (_m *PollAdminUsecase) Delete(c context.Context, id string, deletedBy primitive.ObjectID, deletedAt time.Time) error {
+	ret := _m.Called(c, id, deletedBy, deletedAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(c, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, primitive.ObjectID, time.Time) error); ok {
+		r0 = rf(c, id, deletedBy, deletedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -50,6 +54,34 @@ func (_m *PollAdminUsecase) Delete(c context.Context, id string) error {
 	return r0
 }
 
+// DeleteBallot provides a mock function with given fields: c, id
+func (_m *PollAdminUsecase) DeleteBallot(c context.Context, id string) (domain.Poll, error) {
+	ret := _m.Called(c, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBallot")
+	}
+
+	var r0 domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Poll, error)); ok {
+		return rf(c, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Poll); ok {
+		r0 = rf(c, id)
+	} else {
+		r0 = ret.Get(0).(domain.Poll)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EditPoll provides a mock function with given fields: c, poll
 func (_m *PollAdminUsecase) EditPoll(c context.Context, poll *domain.Poll) error {
 	ret := _m.Called(c, poll)
@@ -68,7 +100,100 @@ func (_m *PollAdminUsecase) EditPoll(c context.Context, poll *domain.Poll) error
 	return r0
 }
 
-// GetBySheetID provides a mock function with given fields: c, sheetID
+// GetBallot provides a mock function with given fields: c, id
+func (_m *PollAdminUsecase) GetBallot(c context.Context, id string) (domain.Ballot, error) {
+	ret := _m.Called(c, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBallot")
+	}
+
+	var r0 domain.Ballot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Ballot, error)); ok {
+		return rf(c, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Ballot); ok {
+		r0 = rf(c, id)
+	} else {
+		r0 = ret.Get(0).(domain.Ballot)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBallots provides a mock function with given fields: c, pollID, pagination
+func (_m *PollAdminUsecase) GetBallots(c context.Context, pollID string, pagination domain.PaginationQuery) ([]domain.Ballot, int64, error) {
+	ret := _m.Called(c, pollID, pagination)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBallots")
+	}
+
+	var r0 []domain.Ballot
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) ([]domain.Ballot, int64, error)); ok {
+		return rf(c, pollID, pagination)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) []domain.Ballot); ok {
+		r0 = rf(c, pollID, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Ballot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
+		r1 = rf(c, pollID, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
+		r2 = rf(c, pollID, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByID provides a mock function with given fields: c, id
+func (_m *PollAdminUsecase) GetByID(c context.Context, id string) (domain.Poll, error) {
+	ret := _m.Called(c, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Poll, error)); ok {
+		return rf(c, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Poll); ok {
+		r0 = rf(c, id)
+	} else {
+		r0 = ret.Get(0).(domain.Poll)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBySheetID provides a mock function with given fields: c, sheetID, pagination
 func (_m *PollAdminUsecase) GetBySheetID(c context.Context, sheetID string, pagination domain.PaginationQuery) ([]domain.Poll, int64, error) {
 	ret := _m.Called(c, sheetID, pagination)
 
@@ -77,6 +202,11 @@ func (_m *PollAdminUsecase) GetBySheetID(c context.Context, sheetID string, pagi
 	}
 
 	var r0 []domain.Poll
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) ([]domain.Poll, int64, error)); ok {
+		return rf(c, sheetID, pagination)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) []domain.Poll); ok {
 		r0 = rf(c, sheetID, pagination)
 	} else {
@@ -85,25 +215,221 @@ func (_m *PollAdminUsecase) GetBySheetID(c context.Context, sheetID string, pagi
 		}
 	}
 
-	var r1 int64
-	if len(ret) > 1 {
-		if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
-			r1 = rf(c, sheetID, pagination)
-		} else if ret.Get(1) != nil {
-			r1 = ret.Get(1).(int64)
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
+		r1 = rf(c, sheetID, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
+		r2 = rf(c, sheetID, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetLeaderboard provides a mock function with given fields: c, sheetID
+func (_m *PollAdminUsecase) GetLeaderboard(c context.Context, sheetID string) ([]domain.QuizLeaderboardEntry, error) {
+	ret := _m.Called(c, sheetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaderboard")
+	}
+
+	var r0 []domain.QuizLeaderboardEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.QuizLeaderboardEntry, error)); ok {
+		return rf(c, sheetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.QuizLeaderboardEntry); ok {
+		r0 = rf(c, sheetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.QuizLeaderboardEntry)
 		}
 	}
 
-	var r2 error
-	if len(ret) > 2 {
-		if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
-			r2 = rf(c, sheetID, pagination)
-		} else {
-			r2 = ret.Error(2)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, sheetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSheet provides a mock function with given fields: c, sheetID
+func (_m *PollAdminUsecase) GetSheet(c context.Context, sheetID string) (domain.Sheet, error) {
+	ret := _m.Called(c, sheetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSheet")
+	}
+
+	var r0 domain.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Sheet, error)); ok {
+		return rf(c, sheetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Sheet); ok {
+		r0 = rf(c, sheetID)
+	} else {
+		r0 = ret.Get(0).(domain.Sheet)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, sheetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrash provides a mock function with given fields: c, sheetID
+func (_m *PollAdminUsecase) GetTrash(c context.Context, sheetID string) ([]domain.Poll, error) {
+	ret := _m.Called(c, sheetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrash")
+	}
+
+	var r0 []domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Poll, error)); ok {
+		return rf(c, sheetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Poll); ok {
+		r0 = rf(c, sheetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Poll)
 		}
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, sheetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrashedByID provides a mock function with given fields: c, id
+func (_m *PollAdminUsecase) GetTrashedByID(c context.Context, id string) (domain.Poll, error) {
+	ret := _m.Called(c, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrashedByID")
+	}
+
+	var r0 domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Poll, error)); ok {
+		return rf(c, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Poll); ok {
+		r0 = rf(c, id)
+	} else {
+		r0 = ret.Get(0).(domain.Poll)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Recount provides a mock function with given fields: c, pollID
+func (_m *PollAdminUsecase) Recount(c context.Context, pollID string) (domain.Poll, error) {
+	ret := _m.Called(c, pollID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Recount")
+	}
+
+	var r0 domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Poll, error)); ok {
+		return rf(c, pollID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Poll); ok {
+		r0 = rf(c, pollID)
+	} else {
+		r0 = ret.Get(0).(domain.Poll)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, pollID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reorder provides a mock function with given fields: c, sheetID, pollIDs
+func (_m *PollAdminUsecase) Reorder(c context.Context, sheetID string, pollIDs []string) ([]domain.Poll, error) {
+	ret := _m.Called(c, sheetID, pollIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reorder")
+	}
+
+	var r0 []domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]domain.Poll, error)); ok {
+		return rf(c, sheetID, pollIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []domain.Poll); ok {
+		r0 = rf(c, sheetID, pollIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Poll)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(c, sheetID, pollIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: c, id, restoredAt
+func (_m *PollAdminUsecase) Restore(c context.Context, id string, restoredAt time.Time) (domain.Poll, error) {
+	ret := _m.Called(c, id, restoredAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (domain.Poll, error)); ok {
+		return rf(c, id, restoredAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) domain.Poll); ok {
+		r0 = rf(c, id, restoredAt)
+	} else {
+		r0 = ret.Get(0).(domain.Poll)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(c, id, restoredAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // NewPollAdminUsecase creates a new instance of PollAdminUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.4. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -14,114 +14,185 @@ type PollClientUsecase struct {
 	mock.Mock
 }
 
-// GetBySheetID provides a mock function with given fields: c, sheetID
-// GetSheet provides a mock function with given fields: c, sheetID
-func (_m *PollClientUsecase) GetSheet(c context.Context, sheetID string) (domain.Sheet, error) {
-	ret := _m.Called(c, sheetID)
+// CreateRespondentToken provides a mock function with given fields: secret, expiry
+func (_m *PollClientUsecase) CreateRespondentToken(secret string, expiry int) (string, error) {
+	ret := _m.Called(secret, expiry)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSheet")
+		panic("no return value specified for CreateRespondentToken")
 	}
 
-	var r0 domain.Sheet
-	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Sheet); ok {
-		r0 = rf(c, sheetID)
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (string, error)); ok {
+		return rf(secret, expiry)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) string); ok {
+		r0 = rf(secret, expiry)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(domain.Sheet)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(secret, expiry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExtractRespondentIDFromToken provides a mock function with given fields: respondentToken, secret
+func (_m *PollClientUsecase) ExtractRespondentIDFromToken(respondentToken string, secret string) (string, error) {
+	ret := _m.Called(respondentToken, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtractRespondentIDFromToken")
+	}
+
+	var r0 string
 	var r1 error
-	if len(ret) > 1 {
-		if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-			r1 = rf(c, sheetID)
-		} else {
-			r1 = ret.Error(1)
-		}
+	if rf, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return rf(respondentToken, secret)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(respondentToken, secret)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(respondentToken, secret)
+	} else {
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
 
+// GetBySheetID provides a mock function with given fields: c, sheetID, pagination
 func (_m *PollClientUsecase) GetBySheetID(c context.Context, sheetID string, pagination domain.PaginationQuery) ([]domain.Poll, int64, error) {
-
 	ret := _m.Called(c, sheetID, pagination)
 
 	if len(ret) == 0 {
-
 		panic("no return value specified for GetBySheetID")
-
 	}
 
 	var r0 []domain.Poll
-
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) ([]domain.Poll, int64, error)); ok {
+		return rf(c, sheetID, pagination)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) []domain.Poll); ok {
-
 		r0 = rf(c, sheetID, pagination)
-
 	} else {
-
 		if ret.Get(0) != nil {
-
 			r0 = ret.Get(0).([]domain.Poll)
-
 		}
-
 	}
 
-	var r1 int64
-
-	if len(ret) > 1 {
-
-		if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
-
-			r1 = rf(c, sheetID, pagination)
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
+		r1 = rf(c, sheetID, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
 
-		} else if ret.Get(1) != nil {
+	if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
+		r2 = rf(c, sheetID, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
 
-			r1 = ret.Get(1).(int64)
+	return r0, r1, r2
+}
 
-		}
+// GetSheet provides a mock function with given fields: c, sheetID
+func (_m *PollClientUsecase) GetSheet(c context.Context, sheetID string) (domain.Sheet, error) {
+	ret := _m.Called(c, sheetID)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetSheet")
 	}
 
-	var r2 error
-
-	if len(ret) > 2 {
+	var r0 domain.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Sheet, error)); ok {
+		return rf(c, sheetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Sheet); ok {
+		r0 = rf(c, sheetID)
+	} else {
+		r0 = ret.Get(0).(domain.Sheet)
+	}
 
-		if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, sheetID)
+	} else {
+		r1 = ret.Error(1)
+	}
 
-			r2 = rf(c, sheetID, pagination)
+	return r0, r1
+}
 
-		} else {
+// SubmitSheet provides a mock function with given fields: c, payload
+func (_m *PollClientUsecase) SubmitSheet(c context.Context, payload domain.PollClientSheetRequest) (*domain.QuizScore, error) {
+	ret := _m.Called(c, payload)
 
-			r2 = ret.Error(2)
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitSheet")
+	}
 
+	var r0 *domain.QuizScore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PollClientSheetRequest) (*domain.QuizScore, error)); ok {
+		return rf(c, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PollClientSheetRequest) *domain.QuizScore); ok {
+		r0 = rf(c, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.QuizScore)
 		}
-
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PollClientSheetRequest) error); ok {
+		r1 = rf(c, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
 
+	return r0, r1
 }
 
 // SubmitVote provides a mock function with given fields: c, payload
-func (_m *PollClientUsecase) SubmitVote(c context.Context, payload domain.PollClientRequest) error {
+func (_m *PollClientUsecase) SubmitVote(c context.Context, payload domain.PollClientRequest) (*domain.QuizScore, error) {
 	ret := _m.Called(c, payload)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SubmitVote")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.PollClientRequest) error); ok {
+	var r0 *domain.QuizScore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PollClientRequest) (*domain.QuizScore, error)); ok {
+		return rf(c, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PollClientRequest) *domain.QuizScore); ok {
 		r0 = rf(c, payload)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.QuizScore)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PollClientRequest) error); ok {
+		r1 = rf(c, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // NewPollClientUsecase creates a new instance of PollClientUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.4. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -7,6 +7,10 @@ import (
 
 	domain "github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+
+	time "time"
 )
 
 // PollRepository is an autogenerated mock type for the PollRepository type
@@ -14,6 +18,42 @@ type PollRepository struct {
 	mock.Mock
 }
 
+// AppendOpinionResponse provides a mock function with given fields: ctx, id, responses
+func (_m *PollRepository) AppendOpinionResponse(ctx context.Context, id string, responses []string) error {
+	ret := _m.Called(ctx, id, responses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendOpinionResponse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, id, responses)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AppendOtherResponse provides a mock function with given fields: ctx, id, response
+func (_m *PollRepository) AppendOtherResponse(ctx context.Context, id string, response string) error {
+	ret := _m.Called(ctx, id, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendOtherResponse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: ctx, poll
 func (_m *PollRepository) Create(ctx context.Context, poll *domain.Poll) error {
 	ret := _m.Called(ctx, poll)
@@ -51,21 +91,31 @@ func (_m *PollRepository) Delete(ctx context.Context, id string) error {
 }
 
 // DeleteBySheetID provides a mock function with given fields: ctx, sheetID
-func (_m *PollRepository) DeleteBySheetID(ctx context.Context, sheetID string) error {
+func (_m *PollRepository) DeleteBySheetID(ctx context.Context, sheetID string) (int64, error) {
 	ret := _m.Called(ctx, sheetID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteBySheetID")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, sheetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
 		r0 = rf(ctx, sheetID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sheetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // EditPoll provides a mock function with given fields: ctx, poll
@@ -95,27 +145,114 @@ func (_m *PollRepository) GetByID(ctx context.Context, id string) (domain.Poll,
 	}
 
 	var r0 domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Poll, error)); ok {
+		return rf(ctx, id)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Poll); ok {
 		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Poll)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeletedBefore provides a mock function with given fields: ctx, cutoff
+func (_m *PollRepository) GetDeletedBefore(ctx context.Context, cutoff time.Time) ([]domain.Poll, error) {
+	ret := _m.Called(ctx, cutoff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedBefore")
+	}
+
+	var r0 []domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.Poll, error)); ok {
+		return rf(ctx, cutoff)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []domain.Poll); ok {
+		r0 = rf(ctx, cutoff)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(domain.Poll)
+			r0 = ret.Get(0).([]domain.Poll)
 		}
 	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, cutoff)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeletedByID provides a mock function with given fields: ctx, id
+func (_m *PollRepository) GetDeletedByID(ctx context.Context, id string) (domain.Poll, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedByID")
+	}
+
+	var r0 domain.Poll
 	var r1 error
-	if len(ret) > 1 {
-		if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-			r1 = rf(ctx, id)
-		} else {
-			r1 = ret.Error(1)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Poll, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Poll); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Poll)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeletedBySheetID provides a mock function with given fields: ctx, sheetID
+func (_m *PollRepository) GetDeletedBySheetID(ctx context.Context, sheetID string) ([]domain.Poll, error) {
+	ret := _m.Called(ctx, sheetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedBySheetID")
+	}
+
+	var r0 []domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Poll, error)); ok {
+		return rf(ctx, sheetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Poll); ok {
+		r0 = rf(ctx, sheetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Poll)
 		}
 	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sheetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
 	return r0, r1
 }
 
-// GetPollBySheetID provides a mock function with given fields: ctx, sheetID
+// GetPollBySheetID provides a mock function with given fields: ctx, sheetID, pagination
 func (_m *PollRepository) GetPollBySheetID(ctx context.Context, sheetID string, pagination domain.PaginationQuery) ([]domain.Poll, int64, error) {
 	ret := _m.Called(ctx, sheetID, pagination)
 
@@ -124,6 +261,11 @@ func (_m *PollRepository) GetPollBySheetID(ctx context.Context, sheetID string,
 	}
 
 	var r0 []domain.Poll
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) ([]domain.Poll, int64, error)); ok {
+		return rf(ctx, sheetID, pagination)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) []domain.Poll); ok {
 		r0 = rf(ctx, sheetID, pagination)
 	} else {
@@ -132,38 +274,50 @@ func (_m *PollRepository) GetPollBySheetID(ctx context.Context, sheetID string,
 		}
 	}
 
-	var r1 int64
-	if len(ret) > 1 {
-		if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
-			r1 = rf(ctx, sheetID, pagination)
-		} else if ret.Get(1) != nil {
-			r1 = ret.Get(1).(int64)
-		}
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
+		r1 = rf(ctx, sheetID, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
 	}
 
-	var r2 error
-	if len(ret) > 2 {
-		if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
-			r2 = rf(ctx, sheetID, pagination)
-		} else {
-			r2 = ret.Error(2)
-		}
+	if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
+		r2 = rf(ctx, sheetID, pagination)
+	} else {
+		r2 = ret.Error(2)
 	}
 
 	return r0, r1, r2
 }
 
-// AppendOpinionResponse provides a mock function with given fields: ctx, id, responses
-func (_m *PollRepository) AppendOpinionResponse(ctx context.Context, id string, responses []string) error {
-	ret := _m.Called(ctx, id, responses)
+// IncrementCorrect provides a mock function with given fields: ctx, id
+func (_m *PollRepository) IncrementCorrect(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AppendOpinionResponse")
+		panic("no return value specified for IncrementCorrect")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
-		r0 = rf(ctx, id, responses)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveOptionMedia provides a mock function with given fields: ctx, id, option, key
+func (_m *PollRepository) RemoveOptionMedia(ctx context.Context, id string, option int, key string) error {
+	ret := _m.Called(ctx, id, option, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveOptionMedia")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) error); ok {
+		r0 = rf(ctx, id, option, key)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -171,9 +325,173 @@ func (_m *PollRepository) AppendOpinionResponse(ctx context.Context, id string,
 	return r0
 }
 
-// SubmitVote provides a mock function with given fields: ctx, id, votes
-func (_m *PollRepository) SubmitVote(ctx context.Context, id string, votes []int) error {
-	ret := _m.Called(ctx, id, votes)
+// Restore provides a mock function with given fields: ctx, id, restoredAt
+func (_m *PollRepository) Restore(ctx context.Context, id string, restoredAt time.Time) error {
+	ret := _m.Called(ctx, id, restoredAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, restoredAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreBySheetID provides a mock function with given fields: ctx, sheetID, deletedAt, restoredAt
+func (_m *PollRepository) RestoreBySheetID(ctx context.Context, sheetID string, deletedAt time.Time, restoredAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, sheetID, deletedAt, restoredAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreBySheetID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (int64, error)); ok {
+		return rf(ctx, sheetID, deletedAt, restoredAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) int64); ok {
+		r0 = rf(ctx, sheetID, deletedAt, restoredAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, sheetID, deletedAt, restoredAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetOptionMedia provides a mock function with given fields: ctx, id, media, previousKey
+func (_m *PollRepository) SetOptionMedia(ctx context.Context, id string, media domain.OptionMedia, previousKey string) error {
+	ret := _m.Called(ctx, id, media, previousKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOptionMedia")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.OptionMedia, string) error); ok {
+		r0 = rf(ctx, id, media, previousKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDelete provides a mock function with given fields: ctx, id, deletedBy, deletedAt
+func (_m *PollRepository) SoftDelete(ctx context.Context, id string, deletedBy primitive.ObjectID, deletedAt time.Time) error {
+	ret := _m.Called(ctx, id, deletedBy, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, primitive.ObjectID, time.Time) error); ok {
+		r0 = rf(ctx, id, deletedBy, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDeleteBySheetID provides a mock function with given fields: ctx, sheetID, deletedBy, deletedAt
+func (_m *PollRepository) SoftDeleteBySheetID(ctx context.Context, sheetID string, deletedBy primitive.ObjectID, deletedAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, sheetID, deletedBy, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteBySheetID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, primitive.ObjectID, time.Time) (int64, error)); ok {
+		return rf(ctx, sheetID, deletedBy, deletedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, primitive.ObjectID, time.Time) int64); ok {
+		r0 = rf(ctx, sheetID, deletedBy, deletedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, primitive.ObjectID, time.Time) error); ok {
+		r1 = rf(ctx, sheetID, deletedBy, deletedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubmitMatrix provides a mock function with given fields: ctx, id, columns
+func (_m *PollRepository) SubmitMatrix(ctx context.Context, id string, columns []int) error {
+	ret := _m.Called(ctx, id, columns)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitMatrix")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []int) error); ok {
+		r0 = rf(ctx, id, columns)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubmitRanking provides a mock function with given fields: ctx, id, order
+func (_m *PollRepository) SubmitRanking(ctx context.Context, id string, order []int) error {
+	ret := _m.Called(ctx, id, order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitRanking")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []int) error); ok {
+		r0 = rf(ctx, id, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubmitSlideValue provides a mock function with given fields: ctx, id, bucket
+func (_m *PollRepository) SubmitSlideValue(ctx context.Context, id string, bucket int) error {
+	ret := _m.Called(ctx, id, bucket)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitSlideValue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, bucket)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubmitVote provides a mock function with given fields: ctx, id, options
+func (_m *PollRepository) SubmitVote(ctx context.Context, id string, options []int) error {
+	ret := _m.Called(ctx, id, options)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SubmitVote")
@@ -181,7 +499,43 @@ func (_m *PollRepository) SubmitVote(ctx context.Context, id string, votes []int
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, []int) error); ok {
-		r0 = rf(ctx, id, votes)
+		r0 = rf(ctx, id, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAggregates provides a mock function with given fields: ctx, poll
+func (_m *PollRepository) UpdateAggregates(ctx context.Context, poll *domain.Poll) error {
+	ret := _m.Called(ctx, poll)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAggregates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Poll) error); ok {
+		r0 = rf(ctx, poll)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePositions provides a mock function with given fields: ctx, polls
+func (_m *PollRepository) UpdatePositions(ctx context.Context, polls []domain.Poll) error {
+	ret := _m.Called(ctx, polls)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePositions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Poll) error); ok {
+		r0 = rf(ctx, polls)
 	} else {
 		r0 = ret.Error(0)
 	}
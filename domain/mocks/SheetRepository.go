@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.4. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -18,6 +18,52 @@ type SheetRepository struct {
 	mock.Mock
 }
 
+// Close provides a mock function with given fields: ctx, id, closedBy, reason, closedAt
+func (_m *SheetRepository) Close(ctx context.Context, id string, closedBy primitive.ObjectID, reason domain.SheetCloseReason, closedAt time.Time) error {
+	ret := _m.Called(ctx, id, closedBy, reason, closedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, primitive.ObjectID, domain.SheetCloseReason, time.Time) error); ok {
+		r0 = rf(ctx, id, closedBy, reason, closedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloseExpired provides a mock function with given fields: ctx, now
+func (_m *SheetRepository) CloseExpired(ctx context.Context, now time.Time) (int64, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseExpired")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: ctx, sheet
 func (_m *SheetRepository) Create(ctx context.Context, sheet domain.Sheet) error {
 	ret := _m.Called(ctx, sheet)
@@ -56,128 +102,253 @@ func (_m *SheetRepository) Delete(ctx context.Context, id string) error {
 
 // GetAll provides a mock function with given fields: ctx, pagination
 func (_m *SheetRepository) GetAll(ctx context.Context, pagination domain.PaginationQuery) ([]domain.Sheet, int64, error) {
-
 	ret := _m.Called(ctx, pagination)
 
 	if len(ret) == 0 {
-
 		panic("no return value specified for GetAll")
-
 	}
 
 	var r0 []domain.Sheet
-
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PaginationQuery) ([]domain.Sheet, int64, error)); ok {
+		return rf(ctx, pagination)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, domain.PaginationQuery) []domain.Sheet); ok {
-
 		r0 = rf(ctx, pagination)
-
 	} else {
-
 		if ret.Get(0) != nil {
-
 			r0 = ret.Get(0).([]domain.Sheet)
-
 		}
+	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PaginationQuery) int64); ok {
+		r1 = rf(ctx, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
 	}
 
-	var r1 int64
+	if rf, ok := ret.Get(2).(func(context.Context, domain.PaginationQuery) error); ok {
+		r2 = rf(ctx, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
 
-	if len(ret) > 1 {
+	return r0, r1, r2
+}
 
-		if rf, ok := ret.Get(1).(func(context.Context, domain.PaginationQuery) int64); ok {
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *SheetRepository) GetByID(ctx context.Context, id string) (domain.Sheet, error) {
+	ret := _m.Called(ctx, id)
 
-			r1 = rf(ctx, pagination)
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
 
-		} else if ret.Get(1) != nil {
+	var r0 domain.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Sheet, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Sheet); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Sheet)
+	}
 
-			r1 = ret.Get(1).(int64)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
 
-		}
+	return r0, r1
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userID, pagination
+func (_m *SheetRepository) GetByUserID(ctx context.Context, userID string, pagination domain.PaginationQuery) ([]domain.Sheet, int64, error) {
+	ret := _m.Called(ctx, userID, pagination)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
 	}
 
+	var r0 []domain.Sheet
+	var r1 int64
 	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) ([]domain.Sheet, int64, error)); ok {
+		return rf(ctx, userID, pagination)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) []domain.Sheet); ok {
+		r0 = rf(ctx, userID, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Sheet)
+		}
+	}
 
-	if len(ret) > 2 {
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
+		r1 = rf(ctx, userID, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
 
-		if rf, ok := ret.Get(2).(func(context.Context, domain.PaginationQuery) error); ok {
+	if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
+		r2 = rf(ctx, userID, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
 
-			r2 = rf(ctx, pagination)
+	return r0, r1, r2
+}
 
-		} else {
+// GetDeleted provides a mock function with given fields: ctx, userID, pagination
+func (_m *SheetRepository) GetDeleted(ctx context.Context, userID string, pagination domain.PaginationQuery) ([]domain.Sheet, int64, error) {
+	ret := _m.Called(ctx, userID, pagination)
 
-			r2 = ret.Error(2)
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeleted")
+	}
 
+	var r0 []domain.Sheet
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) ([]domain.Sheet, int64, error)); ok {
+		return rf(ctx, userID, pagination)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) []domain.Sheet); ok {
+		r0 = rf(ctx, userID, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Sheet)
 		}
+	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
+		r1 = rf(ctx, userID, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
+		r2 = rf(ctx, userID, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
 
+	return r0, r1, r2
 }
 
-// GetByUserID provides a mock function with given fields: ctx, userID
-func (_m *SheetRepository) GetByUserID(ctx context.Context, userID string, pagination domain.PaginationQuery) ([]domain.Sheet, int64, error) {
-
-	ret := _m.Called(ctx, userID, pagination)
+// GetDeletedBefore provides a mock function with given fields: ctx, cutoff
+func (_m *SheetRepository) GetDeletedBefore(ctx context.Context, cutoff time.Time) ([]domain.Sheet, error) {
+	ret := _m.Called(ctx, cutoff)
 
 	if len(ret) == 0 {
-
-		panic("no return value specified for GetByUserID")
-
+		panic("no return value specified for GetDeletedBefore")
 	}
 
 	var r0 []domain.Sheet
-
-	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) []domain.Sheet); ok {
-
-		r0 = rf(ctx, userID, pagination)
-
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.Sheet, error)); ok {
+		return rf(ctx, cutoff)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []domain.Sheet); ok {
+		r0 = rf(ctx, cutoff)
 	} else {
-
 		if ret.Get(0) != nil {
-
 			r0 = ret.Get(0).([]domain.Sheet)
-
 		}
+	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, cutoff)
+	} else {
+		r1 = ret.Error(1)
 	}
 
-	var r1 int64
+	return r0, r1
+}
 
-	if len(ret) > 1 {
+// GetDeletedByID provides a mock function with given fields: ctx, id
+func (_m *SheetRepository) GetDeletedByID(ctx context.Context, id string) (domain.Sheet, error) {
+	ret := _m.Called(ctx, id)
 
-		if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedByID")
+	}
 
-			r1 = rf(ctx, userID, pagination)
+	var r0 domain.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Sheet, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Sheet); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Sheet)
+	}
 
-		} else if ret.Get(1) != nil {
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
 
-			r1 = ret.Get(1).(int64)
+	return r0, r1
+}
 
-		}
+// Restore provides a mock function with given fields: ctx, id, restoredAt
+func (_m *SheetRepository) Restore(ctx context.Context, id string, restoredAt time.Time) error {
+	ret := _m.Called(ctx, id, restoredAt)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
 	}
 
-	var r2 error
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, restoredAt)
+	} else {
+		r0 = ret.Error(0)
+	}
 
-	if len(ret) > 2 {
+	return r0
+}
 
-		if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
+// SoftDelete provides a mock function with given fields: ctx, id, deletedBy, deletedAt
+func (_m *SheetRepository) SoftDelete(ctx context.Context, id string, deletedBy primitive.ObjectID, deletedAt time.Time) error {
+	ret := _m.Called(ctx, id, deletedBy, deletedAt)
 
-			r2 = rf(ctx, userID, pagination)
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDelete")
+	}
 
-		} else {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, primitive.ObjectID, time.Time) error); ok {
+		r0 = rf(ctx, id, deletedBy, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
 
-			r2 = ret.Error(2)
+	return r0
+}
 
-		}
+// Update provides a mock function with given fields: ctx, sheet
+func (_m *SheetRepository) Update(ctx context.Context, sheet domain.Sheet) error {
+	ret := _m.Called(ctx, sheet)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
 	}
 
-	return r0, r1, r2
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Sheet) error); ok {
+		r0 = rf(ctx, sheet)
+	} else {
+		r0 = ret.Error(0)
+	}
 
+	return r0
 }
 
 // UpdateStatus provides a mock function with given fields: ctx, id, status, approvedBy, approvedAt
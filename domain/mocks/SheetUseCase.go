@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.4. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -18,6 +18,52 @@ type SheetUseCase struct {
 	mock.Mock
 }
 
+// Close provides a mock function with given fields: c, id, closedBy, reason, closedAt
+func (_m *SheetUseCase) Close(c context.Context, id string, closedBy primitive.ObjectID, reason domain.SheetCloseReason, closedAt time.Time) error {
+	ret := _m.Called(c, id, closedBy, reason, closedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, primitive.ObjectID, domain.SheetCloseReason, time.Time) error); ok {
+		r0 = rf(c, id, closedBy, reason, closedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloseExpired provides a mock function with given fields: c, now
+func (_m *SheetUseCase) CloseExpired(c context.Context, now time.Time) (int64, error) {
+	ret := _m.Called(c, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseExpired")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(c, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(c, now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(c, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: c, sheet
 func (_m *SheetUseCase) Create(c context.Context, sheet domain.Sheet) error {
 	ret := _m.Called(c, sheet)
@@ -36,17 +82,17 @@ func (_m *SheetUseCase) Create(c context.Context, sheet domain.Sheet) error {
 	return r0
 }
 
-// Delete provides a mock function with given fields: c, id
-func (_m *SheetUseCase) Delete(c context.Context, id string) error {
-	ret := _m.Called(c, id)
+// CreateWithPolls provides a mock function with given fields: c, sheet, polls
+func (_m *SheetUseCase) CreateWithPolls(c context.Context, sheet domain.Sheet, polls []domain.Poll) error {
+	ret := _m.Called(c, sheet, polls)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for CreateWithPolls")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(c, id)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Sheet, []domain.Poll) error); ok {
+		r0 = rf(c, sheet, polls)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -54,130 +100,321 @@ func (_m *SheetUseCase) Delete(c context.Context, id string) error {
 	return r0
 }
 
-// GetAll provides a mock function with given fields: c, pagination
-func (_m *SheetUseCase) GetAll(c context.Context, pagination domain.PaginationQuery) ([]domain.SheetListItem, int64, error) {
-
-	ret := _m.Called(c, pagination)
+// Delete provides a mock function with given fields: c, id
+func (_m *SheetUseCase) Delete(c context.Context, id string) (domain.SheetDeleteResult, error) {
+	ret := _m.Called(c, id)
 
 	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
 
-		panic("no return value specified for GetAll")
+	var r0 domain.SheetDeleteResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.SheetDeleteResult, error)); ok {
+		return rf(c, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.SheetDeleteResult); ok {
+		r0 = rf(c, id)
+	} else {
+		r0 = ret.Get(0).(domain.SheetDeleteResult)
+	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, id)
+	} else {
+		r1 = ret.Error(1)
 	}
 
-	var r0 []domain.SheetListItem
+	return r0, r1
+}
 
-	if rf, ok := ret.Get(0).(func(context.Context, domain.PaginationQuery) []domain.Sheet); ok {
+// Duplicate provides a mock function with given fields: c, sourceID, sheet
+func (_m *SheetUseCase) Duplicate(c context.Context, sourceID string, sheet domain.Sheet) ([]domain.Poll, error) {
+	ret := _m.Called(c, sourceID, sheet)
 
-		r0 = rf(c, pagination)
+	if len(ret) == 0 {
+		panic("no return value specified for Duplicate")
+	}
 
+	var r0 []domain.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.Sheet) ([]domain.Poll, error)); ok {
+		return rf(c, sourceID, sheet)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.Sheet) []domain.Poll); ok {
+		r0 = rf(c, sourceID, sheet)
 	} else {
-
 		if ret.Get(0) != nil {
-
-			r0 = ret.Get(0).([]domain.SheetListItem)
-
+			r0 = ret.Get(0).([]domain.Poll)
 		}
+	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.Sheet) error); ok {
+		r1 = rf(c, sourceID, sheet)
+	} else {
+		r1 = ret.Error(1)
 	}
 
-	var r1 int64
+	return r0, r1
+}
 
-	if len(ret) > 1 {
+// GetAll provides a mock function with given fields: c, pagination
+func (_m *SheetUseCase) GetAll(c context.Context, pagination domain.PaginationQuery) ([]domain.SheetListItem, int64, error) {
+	ret := _m.Called(c, pagination)
 
-		if rf, ok := ret.Get(1).(func(context.Context, domain.PaginationQuery) int64); ok {
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
 
-			r1 = rf(c, pagination)
+	var r0 []domain.SheetListItem
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PaginationQuery) ([]domain.SheetListItem, int64, error)); ok {
+		return rf(c, pagination)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PaginationQuery) []domain.SheetListItem); ok {
+		r0 = rf(c, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SheetListItem)
+		}
+	}
 
-		} else if ret.Get(1) != nil {
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PaginationQuery) int64); ok {
+		r1 = rf(c, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
 
-			r1 = ret.Get(1).(int64)
+	if rf, ok := ret.Get(2).(func(context.Context, domain.PaginationQuery) error); ok {
+		r2 = rf(c, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
 
-		}
+	return r0, r1, r2
+}
 
-	}
+// GetByID provides a mock function with given fields: c, id
+func (_m *SheetUseCase) GetByID(c context.Context, id string) (domain.Sheet, error) {
+	ret := _m.Called(c, id)
 
-	var r2 error
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
 
-	if len(ret) > 2 {
+	var r0 domain.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Sheet, error)); ok {
+		return rf(c, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Sheet); ok {
+		r0 = rf(c, id)
+	} else {
+		r0 = ret.Get(0).(domain.Sheet)
+	}
 
-		if rf, ok := ret.Get(2).(func(context.Context, domain.PaginationQuery) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, id)
+	} else {
+		r1 = ret.Error(1)
+	}
 
-			r2 = rf(c, pagination)
+	return r0, r1
+}
 
-		} else {
+// GetByUserID provides a mock function with given fields: c, userID, pagination
+func (_m *SheetUseCase) GetByUserID(c context.Context, userID string, pagination domain.PaginationQuery) ([]domain.SheetListItem, int64, error) {
+	ret := _m.Called(c, userID, pagination)
 
-			r2 = ret.Error(2)
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
 
+	var r0 []domain.SheetListItem
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) ([]domain.SheetListItem, int64, error)); ok {
+		return rf(c, userID, pagination)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) []domain.SheetListItem); ok {
+		r0 = rf(c, userID, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SheetListItem)
 		}
+	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
+		r1 = rf(c, userID, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
+		r2 = rf(c, userID, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
 
+	return r0, r1, r2
 }
 
-// GetByUserID provides a mock function with given fields: c, userID
-func (_m *SheetUseCase) GetByUserID(c context.Context, userID string, pagination domain.PaginationQuery) ([]domain.SheetListItem, int64, error) {
-
+// GetTrash provides a mock function with given fields: c, userID, pagination
+func (_m *SheetUseCase) GetTrash(c context.Context, userID string, pagination domain.PaginationQuery) ([]domain.SheetListItem, int64, error) {
 	ret := _m.Called(c, userID, pagination)
 
 	if len(ret) == 0 {
-
-		panic("no return value specified for GetByUserID")
-
+		panic("no return value specified for GetTrash")
 	}
 
 	var r0 []domain.SheetListItem
-
-	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) []domain.Sheet); ok {
-
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) ([]domain.SheetListItem, int64, error)); ok {
+		return rf(c, userID, pagination)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PaginationQuery) []domain.SheetListItem); ok {
 		r0 = rf(c, userID, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SheetListItem)
+		}
+	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
+		r1 = rf(c, userID, pagination)
 	} else {
+		r1 = ret.Get(1).(int64)
+	}
 
-		if ret.Get(0) != nil {
+	if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
+		r2 = rf(c, userID, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
 
-			r0 = ret.Get(0).([]domain.SheetListItem)
+	return r0, r1, r2
+}
 
-		}
+// GetTrashedByID provides a mock function with given fields: c, id
+func (_m *SheetUseCase) GetTrashedByID(c context.Context, id string) (domain.Sheet, error) {
+	ret := _m.Called(c, id)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrashedByID")
 	}
 
-	var r1 int64
+	var r0 domain.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Sheet, error)); ok {
+		return rf(c, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Sheet); ok {
+		r0 = rf(c, id)
+	} else {
+		r0 = ret.Get(0).(domain.Sheet)
+	}
 
-	if len(ret) > 1 {
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, id)
+	} else {
+		r1 = ret.Error(1)
+	}
 
-		if rf, ok := ret.Get(1).(func(context.Context, string, domain.PaginationQuery) int64); ok {
+	return r0, r1
+}
 
-			r1 = rf(c, userID, pagination)
+// PurgeTrash provides a mock function with given fields: c, cutoff
+func (_m *SheetUseCase) PurgeTrash(c context.Context, cutoff time.Time) (domain.TrashPurgeResult, error) {
+	ret := _m.Called(c, cutoff)
 
-		} else if ret.Get(1) != nil {
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTrash")
+	}
 
-			r1 = ret.Get(1).(int64)
+	var r0 domain.TrashPurgeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (domain.TrashPurgeResult, error)); ok {
+		return rf(c, cutoff)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) domain.TrashPurgeResult); ok {
+		r0 = rf(c, cutoff)
+	} else {
+		r0 = ret.Get(0).(domain.TrashPurgeResult)
+	}
 
-		}
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(c, cutoff)
+	} else {
+		r1 = ret.Error(1)
+	}
 
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: c, id, restoredAt
+func (_m *SheetUseCase) Restore(c context.Context, id string, restoredAt time.Time) error {
+	ret := _m.Called(c, id, restoredAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
 	}
 
-	var r2 error
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(c, id, restoredAt)
+	} else {
+		r0 = ret.Error(0)
+	}
 
-	if len(ret) > 2 {
+	return r0
+}
 
-		if rf, ok := ret.Get(2).(func(context.Context, string, domain.PaginationQuery) error); ok {
+// Trash provides a mock function with given fields: c, id, deletedBy, deletedAt
+func (_m *SheetUseCase) Trash(c context.Context, id string, deletedBy primitive.ObjectID, deletedAt time.Time) error {
+	ret := _m.Called(c, id, deletedBy, deletedAt)
 
-			r2 = rf(c, userID, pagination)
+	if len(ret) == 0 {
+		panic("no return value specified for Trash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, primitive.ObjectID, time.Time) error); ok {
+		r0 = rf(c, id, deletedBy, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
 
-		} else {
+	return r0
+}
 
-			r2 = ret.Error(2)
+// Update provides a mock function with given fields: c, id, req, now
+func (_m *SheetUseCase) Update(c context.Context, id string, req domain.SheetUpdateRequest, now time.Time) (domain.Sheet, error) {
+	ret := _m.Called(c, id, req, now)
 
-		}
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
 
+	var r0 domain.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.SheetUpdateRequest, time.Time) (domain.Sheet, error)); ok {
+		return rf(c, id, req, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.SheetUpdateRequest, time.Time) domain.Sheet); ok {
+		r0 = rf(c, id, req, now)
+	} else {
+		r0 = ret.Get(0).(domain.Sheet)
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.SheetUpdateRequest, time.Time) error); ok {
+		r1 = rf(c, id, req, now)
+	} else {
+		r1 = ret.Error(1)
+	}
 
+	return r0, r1
 }
 
 // UpdateStatus provides a mock function with given fields: c, id, status, approvedBy, approvedAt
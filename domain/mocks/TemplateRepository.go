@@ -0,0 +1,148 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TemplateRepository is an autogenerated mock type for the TemplateRepository type
+type TemplateRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, template
+func (_m *TemplateRepository) Create(ctx context.Context, template *domain.Template) error {
+	ret := _m.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Template) error); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *TemplateRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *TemplateRepository) GetByID(ctx context.Context, id string) (domain.Template, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Template, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Template); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Template)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, filter, pagination
+func (_m *TemplateRepository) List(ctx context.Context, filter domain.TemplateFilter, pagination domain.PaginationQuery) ([]domain.Template, int64, error) {
+	ret := _m.Called(ctx, filter, pagination)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.Template
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TemplateFilter, domain.PaginationQuery) ([]domain.Template, int64, error)); ok {
+		return rf(ctx, filter, pagination)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TemplateFilter, domain.PaginationQuery) []domain.Template); ok {
+		r0 = rf(ctx, filter, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TemplateFilter, domain.PaginationQuery) int64); ok {
+		r1 = rf(ctx, filter, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.TemplateFilter, domain.PaginationQuery) error); ok {
+		r2 = rf(ctx, filter, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Update provides a mock function with given fields: ctx, template, previousVersion
+func (_m *TemplateRepository) Update(ctx context.Context, template *domain.Template, previousVersion int) error {
+	ret := _m.Called(ctx, template, previousVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Template, int) error); ok {
+		r0 = rf(ctx, template, previousVersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewTemplateRepository creates a new instance of TemplateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTemplateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TemplateRepository {
+	mock := &TemplateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,172 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+
+	time "time"
+)
+
+// TemplateUsecase is an autogenerated mock type for the TemplateUsecase type
+type TemplateUsecase struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: c, id
+func (_m *TemplateUsecase) Delete(c context.Context, id string) error {
+	ret := _m.Called(c, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(c, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: c, id
+func (_m *TemplateUsecase) GetByID(c context.Context, id string) (domain.Template, error) {
+	ret := _m.Called(c, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Template, error)); ok {
+		return rf(c, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Template); ok {
+		r0 = rf(c, id)
+	} else {
+		r0 = ret.Get(0).(domain.Template)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: c, filter, pagination
+func (_m *TemplateUsecase) List(c context.Context, filter domain.TemplateFilter, pagination domain.PaginationQuery) ([]domain.Template, int64, error) {
+	ret := _m.Called(c, filter, pagination)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.Template
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TemplateFilter, domain.PaginationQuery) ([]domain.Template, int64, error)); ok {
+		return rf(c, filter, pagination)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TemplateFilter, domain.PaginationQuery) []domain.Template); ok {
+		r0 = rf(c, filter, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TemplateFilter, domain.PaginationQuery) int64); ok {
+		r1 = rf(c, filter, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.TemplateFilter, domain.PaginationQuery) error); ok {
+		r2 = rf(c, filter, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Publish provides a mock function with given fields: c, req, createdBy, now
+func (_m *TemplateUsecase) Publish(c context.Context, req domain.TemplateRequest, createdBy primitive.ObjectID, now time.Time) (domain.Template, error) {
+	ret := _m.Called(c, req, createdBy, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 domain.Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TemplateRequest, primitive.ObjectID, time.Time) (domain.Template, error)); ok {
+		return rf(c, req, createdBy, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TemplateRequest, primitive.ObjectID, time.Time) domain.Template); ok {
+		r0 = rf(c, req, createdBy, now)
+	} else {
+		r0 = ret.Get(0).(domain.Template)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TemplateRequest, primitive.ObjectID, time.Time) error); ok {
+		r1 = rf(c, req, createdBy, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: c, id, req, now
+func (_m *TemplateUsecase) Update(c context.Context, id string, req domain.TemplateRequest, now time.Time) (domain.Template, error) {
+	ret := _m.Called(c, id, req, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.TemplateRequest, time.Time) (domain.Template, error)); ok {
+		return rf(c, id, req, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.TemplateRequest, time.Time) domain.Template); ok {
+		r0 = rf(c, id, req, now)
+	} else {
+		r0 = ret.Get(0).(domain.Template)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.TemplateRequest, time.Time) error); ok {
+		r1 = rf(c, id, req, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTemplateUsecase creates a new instance of TemplateUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTemplateUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TemplateUsecase {
+	mock := &TemplateUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -53,6 +53,7 @@ type NotificationRepository interface {
 	GetByID(ctx context.Context, id string) (Notification, error)
 	UpdateStatus(ctx context.Context, id string, status NotificationStatus, resolvedBy primitive.ObjectID, updatedAt time.Time) error
 	Delete(ctx context.Context, id string) error
+	DeletePendingBySheetID(ctx context.Context, sheetID string) (int64, error)
 }
 
 type NotificationUsecase interface {
@@ -18,6 +18,10 @@ const (
 	multiChoice  PollType = "multi_choice"
 	slide        PollType = "slide"
 	opinion      PollType = "opinion"
+	ranking      PollType = "ranking"
+	rating       PollType = "rating"
+	nps          PollType = "nps"
+	matrix       PollType = "matrix"
 )
 
 var (
@@ -25,6 +29,10 @@ var (
 	PollTypeMultiChoice  = multiChoice
 	PollTypeSlide        = slide
 	PollTypeOpinion      = opinion
+	PollTypeRanking      = ranking
+	PollTypeRating       = rating
+	PollTypeNPS          = nps
+	PollTypeMatrix       = matrix
 )
 
 func ParsePollType(value string) (PollType, error) {
@@ -37,6 +45,14 @@ func ParsePollType(value string) (PollType, error) {
 		return slide, nil
 	case string(opinion):
 		return opinion, nil
+	case string(ranking):
+		return ranking, nil
+	case string(rating):
+		return rating, nil
+	case string(nps):
+		return nps, nil
+	case string(matrix):
+		return matrix, nil
 	default:
 		return "", fmt.Errorf("invalid poll type: %s", value)
 	}
@@ -44,8 +60,11 @@ func ParsePollType(value string) (PollType, error) {
 
 func (t PollType) MinOptions() int {
 	switch t {
-	case slide, opinion:
+	case opinion:
 		return 1
+	case slide, rating, nps:
+		// Slide and scale polls generate their options from their configuration.
+		return 0
 	default:
 		return 2
 	}
@@ -56,26 +75,103 @@ func (t PollType) VoteSlots(optionCount int) int {
 		optionCount = 0
 	}
 
-	if t == opinion {
+	switch t {
+	case opinion:
 		return 1
+	case nps:
+		return npsScale.PointCount()
+	case matrix:
+		// Matrix answers are counted per row in Poll.MatrixVotes.
+		return 0
 	}
 
 	return optionCount
 }
 
+var (
+	ErrInvalidBallot     = errors.New("invalid ballot")
+	ErrVoteOutOfRange    = fmt.Errorf("%w: vote targets an option that does not exist", ErrInvalidBallot)
+	ErrInvalidVoteValue  = fmt.Errorf("%w: each option accepts a single vote", ErrInvalidBallot)
+	ErrTooManySelections = fmt.Errorf("%w: too many options selected", ErrInvalidBallot)
+	ErrUnsupportedBallot = fmt.Errorf("%w: poll type does not accept votes", ErrInvalidBallot)
+	ErrIncompleteRanking = fmt.Errorf("%w: ranking must order every option exactly once", ErrInvalidBallot)
+)
+
+// ValidateVotes checks a per-option vote payload against the rules of the poll type
+// and returns the indices of the selected options. Ranking polls expect the option
+// indices in preference order instead and return that order unchanged.
+func (t PollType) ValidateVotes(votes []int, optionCount int) ([]int, error) {
+	switch t {
+	case opinion, matrix:
+		return nil, ErrUnsupportedBallot
+	case ranking:
+		return validateRanking(votes, optionCount)
+	}
+
+	slots := t.VoteSlots(optionCount)
+	if len(votes) > slots {
+		return nil, ErrVoteOutOfRange
+	}
+
+	selected := make([]int, 0, len(votes))
+	for idx, vote := range votes {
+		switch vote {
+		case 0:
+			continue
+		case 1:
+			selected = append(selected, idx)
+		default:
+			return nil, ErrInvalidVoteValue
+		}
+	}
+
+	if len(selected) == 0 {
+		return nil, ErrNoVotesSubmitted
+	}
+
+	switch t {
+	case singleChoice, slide, rating, nps:
+		if len(selected) > 1 {
+			return nil, ErrTooManySelections
+		}
+	}
+
+	return selected, nil
+}
+
 type Poll struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty"`
-	SheetID     primitive.ObjectID `bson:"sheetID"`
-	Title       string             `bson:"title"`
-	Category    []string           `bson:"category"`
-	Options     []string           `bson:"options"`
-	PollType    PollType           `bson:"pollType"`
-	Participant int                `bson:"participant"`
-	Votes       []int              `bson:"votes"`
-	Responses   []string           `bson:"responses,omitempty"`
-	Description string             `bson:"description"`
-	CreatedAt   time.Time          `bson:"createdAt"`
-	UpdatedAt   time.Time          `bson:"updatedAt"`
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	SheetID        primitive.ObjectID `bson:"sheetID"`
+	Position       int                `bson:"position"`
+	Title          string             `bson:"title"`
+	Category       []string           `bson:"category"`
+	Options        []string           `bson:"options"`
+	OptionMedia    []OptionMedia      `bson:"optionMedia,omitempty"`
+	Rows           []string           `bson:"rows,omitempty"`
+	PollType       PollType           `bson:"pollType"`
+	Required       bool               `bson:"required,omitempty"`
+	ShuffleOptions bool               `bson:"shuffleOptions,omitempty"`
+	Participant    int                `bson:"participant"`
+	Votes          []int              `bson:"votes"`
+	MatrixVotes    [][]int            `bson:"matrixVotes,omitempty"`
+	Responses      []string           `bson:"responses,omitempty"`
+	AllowOther     bool               `bson:"allowOther,omitempty"`
+	OtherResponses []string           `bson:"otherResponses,omitempty"`
+	MinSelections  int                `bson:"minSelections,omitempty"`
+	MaxSelections  int                `bson:"maxSelections,omitempty"`
+	ShowIf         *DisplayRule       `bson:"showIf,omitempty"`
+	Ranking        *RankingAggregate  `bson:"ranking,omitempty"`
+	Scale          *ScaleConfig       `bson:"scale,omitempty"`
+	Slide          *SlideConfig       `bson:"slide,omitempty"`
+	SlideValues    []float64          `bson:"-"` // read from the ballots of slide polls, never stored on the poll
+	CorrectOptions []int              `bson:"correctOptions,omitempty"`
+	Points         int                `bson:"points,omitempty"`
+	CorrectCount   int                `bson:"correctCount,omitempty"`
+	Description    string             `bson:"description"`
+	DeletedAt      *time.Time         `bson:"deletedAt,omitempty"`
+	DeletedBy      primitive.ObjectID `bson:"deletedBy,omitempty"`
+	CreatedAt      time.Time          `bson:"createdAt"`
+	UpdatedAt      time.Time          `bson:"updatedAt"`
 }
 
 type PollRepository interface {
@@ -83,10 +179,26 @@ type PollRepository interface {
 	GetPollBySheetID(ctx context.Context, sheetID string, pagination PaginationQuery) ([]Poll, int64, error)
 	GetByID(ctx context.Context, id string) (Poll, error)
 	EditPoll(ctx context.Context, poll *Poll) error
-	SubmitVote(ctx context.Context, id string, votes []int) error
+	SubmitVote(ctx context.Context, id string, options []int) error
+	SubmitRanking(ctx context.Context, id string, order []int) error
+	SubmitSlideValue(ctx context.Context, id string, bucket int) error
+	SubmitMatrix(ctx context.Context, id string, columns []int) error
+	IncrementCorrect(ctx context.Context, id string) error
 	AppendOpinionResponse(ctx context.Context, id string, responses []string) error
+	AppendOtherResponse(ctx context.Context, id string, response string) error
+	UpdateAggregates(ctx context.Context, poll *Poll) error
+	UpdatePositions(ctx context.Context, polls []Poll) error
+	SetOptionMedia(ctx context.Context, id string, media OptionMedia, previousKey string) error
+	RemoveOptionMedia(ctx context.Context, id string, option int, key string) error
 	Delete(ctx context.Context, id string) error
-	DeleteBySheetID(ctx context.Context, sheetID string) error
+	DeleteBySheetID(ctx context.Context, sheetID string) (int64, error)
+	SoftDelete(ctx context.Context, id string, deletedBy primitive.ObjectID, deletedAt time.Time) error
+	SoftDeleteBySheetID(ctx context.Context, sheetID string, deletedBy primitive.ObjectID, deletedAt time.Time) (int64, error)
+	Restore(ctx context.Context, id string, restoredAt time.Time) error
+	RestoreBySheetID(ctx context.Context, sheetID string, deletedAt time.Time, restoredAt time.Time) (int64, error)
+	GetDeletedByID(ctx context.Context, id string) (Poll, error)
+	GetDeletedBySheetID(ctx context.Context, sheetID string) ([]Poll, error)
+	GetDeletedBefore(ctx context.Context, cutoff time.Time) ([]Poll, error)
 }
 
 var ErrPollNotFound = errors.New("poll not found")
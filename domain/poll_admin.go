@@ -1,31 +1,121 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
 
 type PollAdminRequest struct {
-	SheetID     string   `form:"sheet_id"`
-	Title       string   `form:"title"`
-	Options     []string `form:"options"`
-	PollType    PollType `form:"poll_type"`
-	Category    []string `form:"category"`
-	Description string   `form:"description"`
+	SheetID        string   `form:"sheet_id"`
+	Title          string   `form:"title"`
+	Options        []string `form:"options"`
+	Rows           []string `form:"rows"`
+	PollType       PollType `form:"poll_type"`
+	Category       []string `form:"category"`
+	Description    string   `form:"description"`
+	ScaleMin       int      `form:"scale_min"`
+	ScaleMax       int      `form:"scale_max"`
+	ScaleStep      int      `form:"scale_step"`
+	ScaleLabels    []string `form:"scale_labels"`
+	SlideMin       float64  `form:"slide_min"`
+	SlideMax       float64  `form:"slide_max"`
+	SlideStep      float64  `form:"slide_step"`
+	SlideUnit      string   `form:"slide_unit"`
+	CorrectOptions []int    `form:"correct_options"`
+	Points         int      `form:"points"`
+	AllowOther     bool     `form:"allow_other"`
+	Required       bool     `form:"required"`
+	ShuffleOptions bool     `form:"shuffle_options"`
+	MinSelections  int      `form:"min_selections"`
+	MaxSelections  int      `form:"max_selections"`
+}
+
+// RequestedScale returns the scale fields of the form, or nil when none were supplied.
+func (r PollAdminRequest) RequestedScale() *ScaleConfig {
+	if r.ScaleMin == 0 && r.ScaleMax == 0 && r.ScaleStep == 0 && len(r.ScaleLabels) == 0 {
+		return nil
+	}
+	return &ScaleConfig{Min: r.ScaleMin, Max: r.ScaleMax, Step: r.ScaleStep, Labels: r.ScaleLabels}
+}
+
+// RequestedSlide returns the slide fields of the form, or nil when none were supplied.
+func (r PollAdminRequest) RequestedSlide() *SlideConfig {
+	if r.SlideMin == 0 && r.SlideMax == 0 && r.SlideStep == 0 && r.SlideUnit == "" {
+		return nil
+	}
+	return &SlideConfig{Min: r.SlideMin, Max: r.SlideMax, Step: r.SlideStep, Unit: r.SlideUnit}
+}
+
+// SheetPoll returns the form as a sheet poll payload, so that polls added or edited one
+// at a time are validated like the polls of a new sheet.
+func (r PollAdminRequest) SheetPoll() SheetCreatePoll {
+	return SheetCreatePoll{
+		Title:          r.Title,
+		Description:    r.Description,
+		Options:        r.Options,
+		Rows:           r.Rows,
+		PollType:       string(r.PollType),
+		Required:       r.Required,
+		ShuffleOptions: r.ShuffleOptions,
+		Category:       r.Category,
+		Scale:          r.RequestedScale(),
+		Slide:          r.RequestedSlide(),
+		CorrectOptions: r.CorrectOptions,
+		Points:         r.Points,
+		AllowOther:     r.AllowOther,
+		MinSelections:  r.MinSelections,
+		MaxSelections:  r.MaxSelections,
+	}
 }
 
 type PollAdminResponse struct {
-	ID          string   `json:"id"`
-	Title       string   `json:"title"`
-	Options     []string `json:"options"`
-	PollType    PollType `json:"poll_type"`
-	Category    []string `json:"category"`
-	Participant int      `json:"participant"`
-	Votes       []int    `json:"votes"`
-	Responses   []string `json:"responses,omitempty"`
-	Description string   `json:"description"`
+	ID             string               `json:"id"`
+	Position       int                  `json:"position"`
+	Title          string               `json:"title"`
+	Options        []string             `json:"options"`
+	OptionMedia    []OptionMedia        `json:"option_media,omitempty"`
+	PollType       PollType             `json:"poll_type"`
+	Required       bool                 `json:"required,omitempty"`
+	ShuffleOptions bool                 `json:"shuffle_options,omitempty"`
+	Category       []string             `json:"category"`
+	Participant    int                  `json:"participant"`
+	Votes          []int                `json:"votes"`
+	Rows           []string             `json:"rows,omitempty"`
+	MatrixVotes    [][]int              `json:"matrix_votes,omitempty"`
+	Responses      []string             `json:"responses,omitempty"`
+	AllowOther     bool                 `json:"allow_other,omitempty"`
+	OtherResponses []string             `json:"other_responses,omitempty"`
+	MinSelections  int                  `json:"min_selections,omitempty"`
+	MaxSelections  int                  `json:"max_selections,omitempty"`
+	ShowIf         *DisplayRule         `json:"show_if,omitempty"`
+	Ranking        *PollRankingResponse `json:"ranking,omitempty"`
+	Scale          *ScaleConfig         `json:"scale,omitempty"`
+	Slide          *SlideConfig         `json:"slide,omitempty"`
+	Stats          *PollNumericStats    `json:"stats,omitempty"`
+	NPS            *PollNPSBreakdown    `json:"nps,omitempty"`
+	CorrectOptions []int                `json:"correct_options,omitempty"`
+	Points         int                  `json:"points,omitempty"`
+	CorrectRate    *float64             `json:"correct_rate,omitempty"`
+	Description    string               `json:"description"`
+	DeletedAt      *time.Time           `json:"deleted_at,omitempty"`
 }
 
 type PollAdminUsecase interface {
 	CreatePoll(c context.Context, poll *Poll) error
 	GetBySheetID(c context.Context, sheetID string, pagination PaginationQuery) ([]Poll, int64, error)
+	GetByID(c context.Context, id string) (Poll, error)
 	EditPoll(c context.Context, poll *Poll) error
-	Delete(c context.Context, id string) error
+	Delete(c context.Context, id string, deletedBy primitive.ObjectID, deletedAt time.Time) error
+	GetTrash(c context.Context, sheetID string) ([]Poll, error)
+	GetTrashedByID(c context.Context, id string) (Poll, error)
+	Restore(c context.Context, id string, restoredAt time.Time) (Poll, error)
+	GetSheet(c context.Context, sheetID string) (Sheet, error)
+	GetBallots(c context.Context, pollID string, pagination PaginationQuery) ([]Ballot, int64, error)
+	GetBallot(c context.Context, id string) (Ballot, error)
+	DeleteBallot(c context.Context, id string) (Poll, error)
+	Recount(c context.Context, pollID string) (Poll, error)
+	GetLeaderboard(c context.Context, sheetID string) ([]QuizLeaderboardEntry, error)
+	Reorder(c context.Context, sheetID string, pollIDs []string) ([]Poll, error)
 }
@@ -3,35 +3,88 @@ package domain
 import (
 	"context"
 	"errors"
+	"fmt"
 )
 
 var (
 	ErrNoVotesSubmitted   = errors.New("no votes submitted")
 	ErrNoOpinionSubmitted = errors.New("no opinion submitted")
+	ErrPhoneRequired      = errors.New("respondent phone is required for this sheet")
+	ErrInvalidPhone       = errors.New("respondent phone is invalid")
+	ErrRespondentRequired = errors.New("respondent token is missing or invalid")
+	ErrAlreadySubmitted   = errors.New("a response from this respondent was already recorded")
+	ErrNoAnswersSubmitted = errors.New("no answers submitted")
+	ErrPollNotInSheet     = fmt.Errorf("%w: poll does not belong to this sheet", ErrInvalidBallot)
+	ErrDuplicateAnswer    = fmt.Errorf("%w: poll answered more than once", ErrInvalidBallot)
 )
 
 type PollClientRequest struct {
-	ID     string   `json:"id" form:"id"`
-	Votes  []int    `json:"votes" form:"votes"`
-	Inputs []string `json:"inputs" form:"inputs"`
+	ID              string       `json:"id" form:"id"`
+	Votes           []int        `json:"votes" form:"votes"`
+	Inputs          []string     `json:"inputs" form:"inputs"`
+	Value           *float64     `json:"value,omitempty" form:"value"`
+	Matrix          [][]int      `json:"matrix,omitempty" form:"-"`
+	Phone           string       `json:"phone,omitempty" form:"phone"`
+	RespondentToken string       `json:"respondent_token,omitempty" form:"respondent_token"`
+	RespondentID    string       `json:"-" form:"-"`
+	Client          BallotClient `json:"-" form:"-"`
+}
+
+type PollClientAnswer struct {
+	ID     string   `json:"id"`
+	Votes  []int    `json:"votes,omitempty"`
+	Inputs []string `json:"inputs,omitempty"`
+	Value  *float64 `json:"value,omitempty"`
+	Matrix [][]int  `json:"matrix,omitempty"`
+}
+
+// PollClientSheetRequest carries a respondent's answers to every poll of a sheet in one submission.
+type PollClientSheetRequest struct {
+	SheetID         string             `json:"sheet_id"`
+	Answers         []PollClientAnswer `json:"answers"`
+	Phone           string             `json:"phone,omitempty"`
+	RespondentToken string             `json:"respondent_token,omitempty"`
+	RespondentID    string             `json:"-"`
+	Client          BallotClient       `json:"-"`
 }
 
 type PollClientResponse struct {
-	ID          string   `json:"id"`
-	Title       string   `json:"title"`
-	Options     []string `json:"options"`
-	PollType    PollType `json:"poll_type"`
-	Description string   `json:"description"`
+	ID            string       `json:"id"`
+	Title         string       `json:"title"`
+	Options       []string     `json:"options"`
+	OptionMedia   []string     `json:"option_media,omitempty"`
+	Rows          []string     `json:"rows,omitempty"`
+	AllowOther    bool         `json:"allow_other,omitempty"`
+	MinSelections int          `json:"min_selections,omitempty"`
+	MaxSelections int          `json:"max_selections,omitempty"`
+	ShowIf        *DisplayRule `json:"show_if,omitempty"`
+	PollType      PollType     `json:"poll_type"`
+	Required      bool         `json:"required,omitempty"`
+	Scale         *ScaleConfig `json:"scale,omitempty"`
+	Slide         *SlideConfig `json:"slide,omitempty"`
+	Description   string       `json:"description"`
+}
+
+// PollSubmitResponse acknowledges a submission; Quiz is set once a quiz sheet is fully answered.
+type PollSubmitResponse struct {
+	Message string     `json:"message"`
+	Quiz    *QuizScore `json:"quiz,omitempty"`
 }
 
 type PollClientSheetMeta struct {
-	ID              string `json:"id"`
-	Title           string `json:"title"`
-	IsPhoneRequired bool   `json:"is_phone_required"`
+	ID              string    `json:"id"`
+	Title           string    `json:"title"`
+	IsPhoneRequired bool      `json:"is_phone_required"`
+	IsQuiz          bool      `json:"is_quiz"`
+	DedupMode       DedupMode `json:"dedup_mode"`
+	RespondentToken string    `json:"respondent_token,omitempty"`
 }
 
 type PollClientUsecase interface {
 	GetBySheetID(c context.Context, sheetID string, pagination PaginationQuery) ([]Poll, int64, error)
 	GetSheet(c context.Context, sheetID string) (Sheet, error)
-	SubmitVote(c context.Context, payload PollClientRequest) error
+	SubmitVote(c context.Context, payload PollClientRequest) (*QuizScore, error)
+	SubmitSheet(c context.Context, payload PollClientSheetRequest) (*QuizScore, error)
+	CreateRespondentToken(secret string, expiry int) (respondentToken string, err error)
+	ExtractRespondentIDFromToken(respondentToken string, secret string) (string, error)
 }
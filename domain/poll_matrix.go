@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrMatrixRowsRequired = errors.New("matrix polls require at least one row")
+	ErrMatrixIncomplete   = fmt.Errorf("%w: every matrix row needs exactly one answer", ErrInvalidBallot)
+)
+
+// ValidateMatrix checks a matrix answer, given as one per-column 0/1 vote array per row,
+// and returns the chosen column index for every row.
+func ValidateMatrix(matrix [][]int, rowCount, columnCount int) ([]int, error) {
+	if len(matrix) == 0 {
+		return nil, ErrNoVotesSubmitted
+	}
+	if len(matrix) != rowCount {
+		return nil, ErrMatrixIncomplete
+	}
+
+	columns := make([]int, 0, rowCount)
+	for _, row := range matrix {
+		selected, err := singleChoice.ValidateVotes(row, columnCount)
+		if errors.Is(err, ErrNoVotesSubmitted) {
+			return nil, ErrMatrixIncomplete
+		}
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, selected[0])
+	}
+
+	return columns, nil
+}
+
+func newMatrixVotes(rowCount, columnCount int) [][]int {
+	votes := make([][]int, rowCount)
+	for idx := range votes {
+		votes[idx] = make([]int, columnCount)
+	}
+	return votes
+}
+
+func (p *Poll) applyMatrix(columns []int) {
+	if p.MatrixVotes == nil {
+		p.MatrixVotes = newMatrixVotes(len(p.Rows), len(p.Options))
+	}
+
+	for row, column := range columns {
+		if row < len(p.MatrixVotes) && column >= 0 && column < len(p.MatrixVotes[row]) {
+			p.MatrixVotes[row][column]++
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "errors"
+
+var ErrInvalidPollOrder = errors.New("poll order must list every poll of the sheet exactly once")
+
+type PollReorderRequest struct {
+	SheetID string   `json:"sheet_id" binding:"required"`
+	PollIDs []string `json:"poll_ids" binding:"required"`
+}
+
+// ReorderPolls returns the sheet's polls in the given order with their 1-based
+// positions updated. The order must contain every poll ID of the sheet exactly once.
+func ReorderPolls(polls []Poll, order []string) ([]Poll, error) {
+	if len(order) != len(polls) {
+		return nil, ErrInvalidPollOrder
+	}
+
+	byID := make(map[string]Poll, len(polls))
+	for _, poll := range polls {
+		byID[poll.ID.Hex()] = poll
+	}
+
+	reordered := make([]Poll, 0, len(order))
+	for idx, id := range order {
+		poll, ok := byID[id]
+		if !ok {
+			return nil, ErrInvalidPollOrder
+		}
+		delete(byID, id)
+
+		poll.Position = idx + 1
+		reordered = append(reordered, poll)
+	}
+
+	return reordered, nil
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// OtherOptionLabel is appended to the options of polls that allow a free-text "Other" answer.
+const OtherOptionLabel = "Other"
+
+var (
+	ErrOtherNotSupported = errors.New("the \"Other\" option is only supported for single and multi choice polls")
+	ErrOtherTextRequired = fmt.Errorf("%w: the \"Other\" option requires a text value", ErrInvalidBallot)
+)
+
+// SupportsOther reports whether polls of this type may offer an "Other" option.
+func (t PollType) SupportsOther() bool {
+	return t == singleChoice || t == multiChoice
+}
+
+// WithOtherOption returns the options with the "Other" entry appended as the last option.
+// The entry is identified by its index, so an option of the poll's own that happens to
+// be labelled "Other" stays a regular option.
+func WithOtherOption(options []string) []string {
+	return append(append([]string(nil), options...), OtherOptionLabel)
+}
+
+// WithoutOtherOption returns the options of a poll that allowed "Other" without the
+// entry WithOtherOption appended, e.g. when an edited poll is sent back as returned.
+func WithoutOtherOption(options []string) []string {
+	if len(options) == 0 || options[len(options)-1] != OtherOptionLabel {
+		return options
+	}
+	return options[:len(options)-1]
+}
+
+// OtherIndex returns the option index of the "Other" entry, or -1 when the poll has none.
+func (p Poll) OtherIndex() int {
+	if !p.AllowOther || len(p.Options) == 0 {
+		return -1
+	}
+	return len(p.Options) - 1
+}
+
+// OtherText returns the trimmed text accompanying an "Other" selection, or
+// ErrOtherTextRequired when "Other" was selected without one.
+func (p Poll) OtherText(selected []int, inputs []string) (string, error) {
+	otherIndex := p.OtherIndex()
+	if otherIndex < 0 {
+		return "", nil
+	}
+
+	for _, option := range selected {
+		if option != otherIndex {
+			continue
+		}
+		for _, input := range inputs {
+			if text := strings.TrimSpace(input); text != "" {
+				return text, nil
+			}
+		}
+		return "", ErrOtherTextRequired
+	}
+
+	return "", nil
+}
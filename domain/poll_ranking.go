@@ -0,0 +1,84 @@
+package domain
+
+// RankingAggregate holds the derived results of a ranking poll, indexed like Poll.Options.
+// Poll.Votes counts first-place picks for the same poll.
+type RankingAggregate struct {
+	// BordaScores awards optionCount-1 points for first place down to 0 for last.
+	BordaScores []int `bson:"bordaScores"`
+	// PositionTotals sums the 1-based position each option received across ballots.
+	PositionTotals []int `bson:"positionTotals"`
+}
+
+type PollRankingResponse struct {
+	BordaScores      []int     `json:"borda_scores"`
+	AveragePositions []float64 `json:"average_positions"`
+}
+
+func newRankingAggregate(optionCount int) *RankingAggregate {
+	return &RankingAggregate{
+		BordaScores:    make([]int, optionCount),
+		PositionTotals: make([]int, optionCount),
+	}
+}
+
+// validateRanking accepts a ranking only when it lists every option index exactly once.
+func validateRanking(order []int, optionCount int) ([]int, error) {
+	if len(order) == 0 {
+		return nil, ErrNoVotesSubmitted
+	}
+	if len(order) != optionCount {
+		return nil, ErrIncompleteRanking
+	}
+
+	seen := make([]bool, optionCount)
+	for _, option := range order {
+		if option < 0 || option >= optionCount {
+			return nil, ErrVoteOutOfRange
+		}
+		if seen[option] {
+			return nil, ErrIncompleteRanking
+		}
+		seen[option] = true
+	}
+
+	return append([]int(nil), order...), nil
+}
+
+// BordaPoints returns the points an option earns for the given 0-based position.
+func BordaPoints(position, optionCount int) int {
+	return optionCount - 1 - position
+}
+
+// AverageRankPositions returns the mean 1-based position of every option, or nil when
+// the poll is not a ranking poll. Options nobody has ranked yet report 0.
+func (p Poll) AverageRankPositions() []float64 {
+	if p.PollType != ranking || p.Ranking == nil {
+		return nil
+	}
+
+	averages := make([]float64, len(p.Ranking.PositionTotals))
+	if p.Participant == 0 {
+		return averages
+	}
+	for idx, total := range p.Ranking.PositionTotals {
+		averages[idx] = float64(total) / float64(p.Participant)
+	}
+	return averages
+}
+
+func (p *Poll) applyRanking(order []int) {
+	if p.Ranking == nil {
+		p.Ranking = newRankingAggregate(len(p.Options))
+	}
+
+	for position, option := range order {
+		if option < 0 || option >= len(p.Ranking.BordaScores) {
+			continue
+		}
+		p.Ranking.BordaScores[option] += BordaPoints(position, len(p.Options))
+		p.Ranking.PositionTotals[option] += position + 1
+	}
+	if len(order) > 0 && order[0] >= 0 && order[0] < len(p.Votes) {
+		p.Votes[order[0]]++
+	}
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const ErrorCodeRequiredPollsMissing = "required_polls_missing"
+
+var ErrRequiredPollsMissing = fmt.Errorf("%w: required polls are not answered", ErrInvalidBallot)
+
+// MissingRequiredPollsError lists the required polls a sheet submission left unanswered.
+type MissingRequiredPollsError struct {
+	PollIDs []string
+}
+
+func (e *MissingRequiredPollsError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrRequiredPollsMissing.Error(), strings.Join(e.PollIDs, ", "))
+}
+
+func (e *MissingRequiredPollsError) Unwrap() error {
+	return ErrRequiredPollsMissing
+}
+
+// CheckRequiredPolls returns a MissingRequiredPollsError when a required poll that is
+// visible to the respondent has no answer in selections. Hidden polls are never required.
+func CheckRequiredPolls(polls []Poll, selections map[primitive.ObjectID][]int) error {
+	var missing []string
+	for _, poll := range polls {
+		if !poll.Required || !poll.IsVisible(selections) {
+			continue
+		}
+		if _, ok := selections[poll.ID]; !ok {
+			missing = append(missing, poll.ID.Hex())
+		}
+	}
+
+	if len(missing) > 0 {
+		return &MissingRequiredPollsError{PollIDs: missing}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	ErrDanglingDisplayRule = errors.New("display rule references a poll that does not exist in the sheet")
+	ErrSelfDisplayRule     = errors.New("display rule cannot reference its own poll")
+	ErrDisplayRuleSource   = errors.New("display rules can only reference single choice, multi choice, rating or nps polls")
+	ErrDisplayRuleOptions  = errors.New("display rule options must reference options of the triggering poll")
+	ErrDisplayRuleCycle    = errors.New("display rules form a cycle")
+	ErrPollHidden          = fmt.Errorf("%w: poll is hidden by its display rule", ErrInvalidBallot)
+)
+
+// DisplayRule shows a poll only when the referenced poll of the same sheet was
+// answered with at least one of Options.
+type DisplayRule struct {
+	PollID  primitive.ObjectID `bson:"pollID" json:"poll_id"`
+	Options []int              `bson:"options" json:"options"`
+}
+
+// SheetDisplayRule is the display rule of a poll in a sheet create request. Poll is the
+// 1-based position of the triggering poll in the request, since no IDs exist yet.
+type SheetDisplayRule struct {
+	Poll    int   `bson:"poll" json:"poll"`
+	Options []int `bson:"options" json:"options"`
+}
+
+// CanTriggerDisplay reports whether answers to polls of this type can drive display rules.
+func (t PollType) CanTriggerDisplay() bool {
+	switch t {
+	case singleChoice, multiChoice, rating, nps:
+		return true
+	}
+	return false
+}
+
+// LinkDisplayRules validates the display rules of a sheet create request against the
+// polls built from it and sets Poll.ShowIf. The polls must already have their IDs.
+func LinkDisplayRules(polls []Poll, rules []*SheetDisplayRule) error {
+	targets := make([]int, len(polls))
+	for idx := range targets {
+		targets[idx] = -1
+	}
+
+	for idx, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		source := rule.Poll - 1
+		if source < 0 || source >= len(polls) {
+			return fmt.Errorf("poll %d: %w", idx+1, ErrDanglingDisplayRule)
+		}
+		if source == idx {
+			return fmt.Errorf("poll %d: %w", idx+1, ErrSelfDisplayRule)
+		}
+		if !polls[source].PollType.CanTriggerDisplay() {
+			return fmt.Errorf("poll %d: %w", idx+1, ErrDisplayRuleSource)
+		}
+		if len(rule.Options) == 0 {
+			return fmt.Errorf("poll %d: %w", idx+1, ErrDisplayRuleOptions)
+		}
+		for _, option := range rule.Options {
+			if option < 0 || option >= len(polls[source].Options) {
+				return fmt.Errorf("poll %d: %w", idx+1, ErrDisplayRuleOptions)
+			}
+		}
+		targets[idx] = source
+	}
+
+	for idx := range polls {
+		seen := map[int]struct{}{idx: {}}
+		for next := targets[idx]; next >= 0; next = targets[next] {
+			if _, ok := seen[next]; ok {
+				return fmt.Errorf("poll %d: %w", idx+1, ErrDisplayRuleCycle)
+			}
+			seen[next] = struct{}{}
+		}
+	}
+
+	for idx, rule := range rules {
+		if targets[idx] < 0 {
+			continue
+		}
+		polls[idx].ShowIf = &DisplayRule{
+			PollID:  polls[targets[idx]].ID,
+			Options: append([]int(nil), rule.Options...),
+		}
+	}
+
+	return nil
+}
+
+// IsVisible reports whether the poll is shown given the options selected per poll ID.
+func (p Poll) IsVisible(selections map[primitive.ObjectID][]int) bool {
+	if p.ShowIf == nil {
+		return true
+	}
+
+	selected, ok := selections[p.ShowIf.PollID]
+	if !ok {
+		return false
+	}
+	for _, option := range selected {
+		for _, trigger := range p.ShowIf.Options {
+			if option == trigger {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// maxScalePoints bounds rating scales so a poll cannot allocate an unbounded distribution.
+const maxScalePoints = 101
+
+var ErrInvalidScale = errors.New("invalid rating scale")
+
+// ScaleConfig describes the points of a rating-scale or NPS poll. The poll's Options hold
+// one entry per point so Votes doubles as the response distribution.
+type ScaleConfig struct {
+	Min    int      `bson:"min" json:"min"`
+	Max    int      `bson:"max" json:"max"`
+	Step   int      `bson:"step" json:"step"`
+	Labels []string `bson:"labels,omitempty" json:"labels,omitempty"`
+}
+
+var defaultRatingScale = ScaleConfig{Min: 1, Max: 5, Step: 1}
+
+var npsScale = ScaleConfig{Min: 0, Max: 10, Step: 1}
+
+// NPS buckets: 9-10 promote, 7-8 are passive and 0-6 detract.
+const (
+	npsPromoterMin = 9
+	npsPassiveMin  = 7
+)
+
+type PollNumericStats struct {
+	Count  int     `json:"count"`
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+	StdDev float64 `json:"std_dev"`
+}
+
+type PollNPSBreakdown struct {
+	Promoters  int     `json:"promoters"`
+	Passives   int     `json:"passives"`
+	Detractors int     `json:"detractors"`
+	Score      float64 `json:"score"`
+}
+
+// IsScale reports whether the poll type records a single point on a numeric scale.
+func (t PollType) IsScale() bool {
+	return t == rating || t == nps
+}
+
+// ResolveScale returns the scale a poll of this type should use, applying defaults and
+// validating the requested configuration. Non-scale types return nil.
+func (t PollType) ResolveScale(requested *ScaleConfig) (*ScaleConfig, error) {
+	switch t {
+	case nps:
+		scale := npsScale
+		if requested != nil && len(requested.Labels) > 0 {
+			scale.Labels = requested.Labels
+		}
+		if err := scale.Validate(); err != nil {
+			return nil, err
+		}
+		return &scale, nil
+	case rating:
+		scale := defaultRatingScale
+		if requested != nil && (requested.Min != 0 || requested.Max != 0 || requested.Step != 0) {
+			scale = *requested
+			if scale.Step == 0 {
+				scale.Step = 1
+			}
+		} else if requested != nil {
+			scale.Labels = requested.Labels
+		}
+		if err := scale.Validate(); err != nil {
+			return nil, err
+		}
+		return &scale, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (s ScaleConfig) Validate() error {
+	if s.Step <= 0 {
+		return fmt.Errorf("%w: step must be positive", ErrInvalidScale)
+	}
+	if s.Max <= s.Min {
+		return fmt.Errorf("%w: max must be greater than min", ErrInvalidScale)
+	}
+	if (s.Max-s.Min)%s.Step != 0 {
+		return fmt.Errorf("%w: range must be a multiple of step", ErrInvalidScale)
+	}
+	if s.PointCount() > maxScalePoints {
+		return fmt.Errorf("%w: too many points", ErrInvalidScale)
+	}
+	if len(s.Labels) > 0 && len(s.Labels) != s.PointCount() {
+		return fmt.Errorf("%w: labels must match the number of points", ErrInvalidScale)
+	}
+	return nil
+}
+
+func (s ScaleConfig) PointCount() int {
+	if s.Step <= 0 || s.Max < s.Min {
+		return 0
+	}
+	return (s.Max-s.Min)/s.Step + 1
+}
+
+// Value returns the numeric value of the point at index.
+func (s ScaleConfig) Value(index int) int {
+	return s.Min + index*s.Step
+}
+
+// OptionLabels renders every point as a poll option; the numeric value is always shown
+// and a configured label is appended to it.
+func (s ScaleConfig) OptionLabels() []string {
+	labels := make([]string, s.PointCount())
+	for idx := range labels {
+		labels[idx] = strconv.Itoa(s.Value(idx))
+		if idx < len(s.Labels) && s.Labels[idx] != "" {
+			labels[idx] += " - " + s.Labels[idx]
+		}
+	}
+	return labels
+}
+
+// NumericStatistics summarises the answers of a scale or configured slide poll, or returns
+// nil for other poll types. Slide polls need SlideValues loaded from their ballots.
+func (p Poll) NumericStatistics() *PollNumericStats {
+	if p.PollType == slide && p.Slide != nil {
+		return numericStats(p.SlideValues)
+	}
+	if !p.PollType.IsScale() || p.Scale == nil {
+		return nil
+	}
+
+	values := make([]float64, 0)
+	for idx, count := range p.Votes {
+		for i := 0; i < count; i++ {
+			values = append(values, float64(p.Scale.Value(idx)))
+		}
+	}
+
+	return numericStats(values)
+}
+
+// NPSBreakdown splits NPS responses into promoters, passives and detractors and computes
+// the score (percentage of promoters minus percentage of detractors).
+func (p Poll) NPSBreakdown() *PollNPSBreakdown {
+	if p.PollType != nps || p.Scale == nil {
+		return nil
+	}
+
+	breakdown := PollNPSBreakdown{}
+	total := 0
+	for idx, count := range p.Votes {
+		value := p.Scale.Value(idx)
+		switch {
+		case value >= npsPromoterMin:
+			breakdown.Promoters += count
+		case value >= npsPassiveMin:
+			breakdown.Passives += count
+		default:
+			breakdown.Detractors += count
+		}
+		total += count
+	}
+
+	if total > 0 {
+		breakdown.Score = float64(breakdown.Promoters-breakdown.Detractors) * 100 / float64(total)
+	}
+
+	return &breakdown
+}
+
+func numericStats(values []float64) *PollNumericStats {
+	stats := PollNumericStats{Count: len(values)}
+	if len(values) == 0 {
+		return &stats
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	sum := 0.0
+	for _, value := range sorted {
+		sum += value
+	}
+	stats.Mean = sum / float64(len(sorted))
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		stats.Median = (sorted[middle-1] + sorted[middle]) / 2
+	} else {
+		stats.Median = sorted[middle]
+	}
+
+	variance := 0.0
+	for _, value := range sorted {
+		variance += (value - stats.Mean) * (value - stats.Mean)
+	}
+	stats.StdDev = math.Sqrt(variance / float64(len(sorted)))
+
+	return &stats
+}
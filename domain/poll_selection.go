@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidSelectionLimits = errors.New("invalid selection limits")
+	ErrTooFewSelections       = fmt.Errorf("%w: too few options selected", ErrInvalidBallot)
+)
+
+// ResolveSelectionLimits validates the min/max selection settings of a poll. Zero means
+// no limit; limits are only supported on multi choice polls.
+func (t PollType) ResolveSelectionLimits(minSelections, maxSelections int, optionCount int) (int, int, error) {
+	if minSelections == 0 && maxSelections == 0 {
+		return 0, 0, nil
+	}
+	if t != multiChoice {
+		return 0, 0, fmt.Errorf("%w: selection limits are only supported for multi choice polls", ErrInvalidSelectionLimits)
+	}
+	if minSelections < 0 || maxSelections < 0 {
+		return 0, 0, fmt.Errorf("%w: limits cannot be negative", ErrInvalidSelectionLimits)
+	}
+	if minSelections > optionCount || maxSelections > optionCount {
+		return 0, 0, fmt.Errorf("%w: limits cannot exceed the %d options", ErrInvalidSelectionLimits, optionCount)
+	}
+	if maxSelections > 0 && minSelections > maxSelections {
+		return 0, 0, fmt.Errorf("%w: min_selections cannot exceed max_selections", ErrInvalidSelectionLimits)
+	}
+
+	return minSelections, maxSelections, nil
+}
+
+// ValidateSelectionCount checks the number of selected options against the poll's limits.
+func (p Poll) ValidateSelectionCount(selected []int) error {
+	if p.MinSelections > 0 && len(selected) < p.MinSelections {
+		return fmt.Errorf("%w: select at least %d", ErrTooFewSelections, p.MinSelections)
+	}
+	if p.MaxSelections > 0 && len(selected) > p.MaxSelections {
+		return fmt.Errorf("%w: select at most %d", ErrTooManySelections, p.MaxSelections)
+	}
+	return nil
+}
+
+// SelectionLimitLabel describes the poll's selection limits, e.g. "1 to 3", or "" when unlimited.
+func (p Poll) SelectionLimitLabel() string {
+	switch {
+	case p.MinSelections > 0 && p.MaxSelections > 0:
+		return fmt.Sprintf("%d to %d", p.MinSelections, p.MaxSelections)
+	case p.MinSelections > 0:
+		return fmt.Sprintf("at least %d", p.MinSelections)
+	case p.MaxSelections > 0:
+		return fmt.Sprintf("up to %d", p.MaxSelections)
+	}
+	return ""
+}
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+)
+
+var (
+	ErrShuffleNotSupported = errors.New("shuffle_options is only supported for single choice, multi choice, ranking and matrix polls")
+	ErrShuffledVoteLength  = fmt.Errorf("%w: votes must hold one entry per shuffled option", ErrInvalidBallot)
+)
+
+// SupportsShuffle reports whether the options of polls of this type may be shuffled.
+// Scales, slides and NPS keep their natural order.
+func (t PollType) SupportsShuffle() bool {
+	switch t {
+	case singleChoice, multiChoice, ranking, matrix:
+		return true
+	}
+	return false
+}
+
+// Permutation returns a deterministic permutation of n indices for the respondent seed
+// and subject (a poll or sheet ID). The value at each display position is the
+// canonical index shown there.
+func Permutation(seed, subject string, n int) []int {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(seed + ":" + subject))
+	return rand.New(rand.NewSource(int64(hash.Sum64()))).Perm(n)
+}
+
+// OptionPermutation returns the respondent's option order for the poll, or nil when the
+// options are shown in their canonical order. The "Other" option always stays last.
+func (p Poll) OptionPermutation(seed string) []int {
+	if !p.ShuffleOptions || seed == "" || !p.PollType.SupportsShuffle() {
+		return nil
+	}
+
+	shuffled := len(p.Options)
+	if p.OtherIndex() >= 0 {
+		shuffled--
+	}
+
+	perm := Permutation(seed, p.ID.Hex(), shuffled)
+	for idx := shuffled; idx < len(p.Options); idx++ {
+		perm = append(perm, idx)
+	}
+	return perm
+}
+
+// PermuteOptions returns the per-option values in display order for the permutation.
+func PermuteOptions(options []string, perm []int) []string {
+	if perm == nil || len(options) != len(perm) {
+		return options
+	}
+
+	displayed := make([]string, len(perm))
+	for display, canonical := range perm {
+		displayed[display] = options[canonical]
+	}
+	return displayed
+}
+
+// DisplayIndices maps canonical option indices to the positions they are shown at.
+func DisplayIndices(options []int, perm []int) []int {
+	if perm == nil {
+		return options
+	}
+
+	positions := make(map[int]int, len(perm))
+	for display, canonical := range perm {
+		positions[canonical] = display
+	}
+
+	displayed := make([]int, 0, len(options))
+	for _, option := range options {
+		if display, ok := positions[option]; ok {
+			displayed = append(displayed, display)
+		}
+	}
+	return displayed
+}
+
+// CanonicalAnswer maps an answer given against the respondent's shuffled options back to
+// the canonical option indices. Ranking orders that do not fit the options are left
+// untouched so validation reports them; per-option vote arrays must cover every option.
+func (p Poll) CanonicalAnswer(answer PollClientAnswer, seed string) (PollClientAnswer, error) {
+	perm := p.OptionPermutation(seed)
+	if perm == nil {
+		return answer, nil
+	}
+
+	switch p.PollType {
+	case ranking:
+		order := make([]int, len(answer.Votes))
+		for idx, display := range answer.Votes {
+			if display < 0 || display >= len(perm) {
+				return answer, nil
+			}
+			order[idx] = perm[display]
+		}
+		answer.Votes = order
+	case matrix:
+		rows := make([][]int, len(answer.Matrix))
+		for idx, row := range answer.Matrix {
+			selection, err := canonicalSelection(row, perm)
+			if err != nil {
+				return answer, err
+			}
+			rows[idx] = selection
+		}
+		answer.Matrix = rows
+	default:
+		selection, err := canonicalSelection(answer.Votes, perm)
+		if err != nil {
+			return answer, err
+		}
+		answer.Votes = selection
+	}
+
+	return answer, nil
+}
+
+// canonicalSelection reorders a per-option vote array from display to canonical order.
+// A shorter array cannot be mapped, since its missing entries have no display position.
+func canonicalSelection(votes []int, perm []int) ([]int, error) {
+	if len(votes) != len(perm) {
+		return nil, ErrShuffledVoteLength
+	}
+
+	canonical := make([]int, len(perm))
+	for display, vote := range votes {
+		canonical[perm[display]] = vote
+	}
+	return canonical, nil
+}
+
+// ShufflePolls returns the polls in the respondent's order for the sheet.
+func ShufflePolls(polls []Poll, sheetID string, seed string) []Poll {
+	if seed == "" {
+		return polls
+	}
+
+	shuffled := make([]Poll, len(polls))
+	for display, canonical := range Permutation(seed, sheetID, len(polls)) {
+		shuffled[display] = polls[canonical]
+	}
+	return shuffled
+}
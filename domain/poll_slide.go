@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// slideBucketLimit caps the histogram kept for slide polls; wider ranges group several
+// steps into one bucket.
+const slideBucketLimit = 10
+
+const slideStepTolerance = 1e-9
+
+var (
+	ErrSlideValueRequired   = fmt.Errorf("%w: slide polls require a numeric value", ErrInvalidBallot)
+	ErrSlideValueOutOfRange = fmt.Errorf("%w: value is outside the slide range", ErrInvalidBallot)
+	ErrSlideValueOffStep    = fmt.Errorf("%w: value does not match the slide step", ErrInvalidBallot)
+	ErrInvalidSlide         = errors.New("invalid slide settings")
+)
+
+// SlideConfig bounds the numeric value a slide poll accepts. The poll's Options hold one
+// label per histogram bucket so Votes doubles as the histogram.
+type SlideConfig struct {
+	Min  float64 `bson:"min" json:"min"`
+	Max  float64 `bson:"max" json:"max"`
+	Step float64 `bson:"step" json:"step"`
+	Unit string  `bson:"unit,omitempty" json:"unit,omitempty"`
+}
+
+var defaultSlide = SlideConfig{Min: 0, Max: 100, Step: 1}
+
+// ResolveSlide returns the slide settings a poll of this type should use, applying defaults
+// and validating the requested configuration. Other types return nil.
+func (t PollType) ResolveSlide(requested *SlideConfig) (*SlideConfig, error) {
+	if t != slide {
+		return nil, nil
+	}
+
+	config := defaultSlide
+	if requested != nil {
+		config = *requested
+		if config.Min == 0 && config.Max == 0 {
+			config.Min, config.Max = defaultSlide.Min, defaultSlide.Max
+		}
+		if config.Step == 0 {
+			config.Step = defaultSlide.Step
+		}
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (s SlideConfig) Validate() error {
+	if s.Step <= 0 {
+		return fmt.Errorf("%w: step must be positive", ErrInvalidSlide)
+	}
+	if s.Max <= s.Min {
+		return fmt.Errorf("%w: max must be greater than min", ErrInvalidSlide)
+	}
+	steps := (s.Max - s.Min) / s.Step
+	if math.Abs(steps-math.Round(steps)) > slideStepTolerance {
+		return fmt.Errorf("%w: range must be a multiple of step", ErrInvalidSlide)
+	}
+	return nil
+}
+
+// ValidateValue checks that value lies within the range and on a step.
+func (s SlideConfig) ValidateValue(value *float64) (float64, error) {
+	if value == nil {
+		return 0, ErrSlideValueRequired
+	}
+	if *value < s.Min || *value > s.Max || math.IsNaN(*value) {
+		return 0, ErrSlideValueOutOfRange
+	}
+	steps := (*value - s.Min) / s.Step
+	if math.Abs(steps-math.Round(steps)) > slideStepTolerance {
+		return 0, ErrSlideValueOffStep
+	}
+	return *value, nil
+}
+
+func (s SlideConfig) pointCount() int {
+	return int(math.Round((s.Max-s.Min)/s.Step)) + 1
+}
+
+func (s SlideConfig) stepsPerBucket() int {
+	return int(math.Ceil(float64(s.pointCount()) / slideBucketLimit))
+}
+
+// BucketCount returns the number of histogram buckets for the range.
+func (s SlideConfig) BucketCount() int {
+	perBucket := s.stepsPerBucket()
+	return (s.pointCount() + perBucket - 1) / perBucket
+}
+
+// Bucket returns the histogram bucket a valid value falls into.
+func (s SlideConfig) Bucket(value float64) int {
+	point := int(math.Round((value - s.Min) / s.Step))
+	bucket := point / s.stepsPerBucket()
+	if bucket >= s.BucketCount() {
+		bucket = s.BucketCount() - 1
+	}
+	if bucket < 0 {
+		bucket = 0
+	}
+	return bucket
+}
+
+// BucketLabels names every histogram bucket by the values it covers.
+func (s SlideConfig) BucketLabels() []string {
+	perBucket := s.stepsPerBucket()
+	labels := make([]string, s.BucketCount())
+	for idx := range labels {
+		low := s.Min + float64(idx*perBucket)*s.Step
+		high := math.Min(s.Min+float64((idx+1)*perBucket-1)*s.Step, s.Max)
+		if perBucket == 1 || low == high {
+			labels[idx] = s.Format(low)
+		} else {
+			labels[idx] = s.Format(low) + " - " + s.Format(high)
+		}
+	}
+	return labels
+}
+
+// Format renders a value with the configured unit.
+func (s SlideConfig) Format(value float64) string {
+	formatted := strconv.FormatFloat(value, 'f', -1, 64)
+	if s.Unit != "" {
+		formatted += " " + s.Unit
+	}
+	return formatted
+}
@@ -0,0 +1,551 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestValidateVotes(t *testing.T) {
+
+	t.Run("single choice", func(t *testing.T) {
+		selected, err := domain.PollTypeSingleChoice.ValidateVotes([]int{0, 1, 0}, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1}, selected)
+
+		_, err = domain.PollTypeSingleChoice.ValidateVotes([]int{1, 1, 0}, 3)
+		assert.ErrorIs(t, err, domain.ErrTooManySelections)
+		assert.ErrorIs(t, err, domain.ErrInvalidBallot)
+	})
+
+	t.Run("multi choice", func(t *testing.T) {
+		selected, err := domain.PollTypeMultiChoice.ValidateVotes([]int{1, 0, 1}, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 2}, selected)
+
+		_, err = domain.PollTypeMultiChoice.ValidateVotes([]int{0, 0, 0}, 3)
+		assert.ErrorIs(t, err, domain.ErrNoVotesSubmitted)
+	})
+
+	t.Run("malformed payload", func(t *testing.T) {
+		_, err := domain.PollTypeMultiChoice.ValidateVotes([]int{0, 0, 0, 1}, 3)
+		assert.ErrorIs(t, err, domain.ErrVoteOutOfRange)
+
+		_, err = domain.PollTypeMultiChoice.ValidateVotes([]int{-1, 1}, 2)
+		assert.ErrorIs(t, err, domain.ErrInvalidVoteValue)
+
+		_, err = domain.PollTypeSingleChoice.ValidateVotes([]int{1000, 0}, 2)
+		assert.ErrorIs(t, err, domain.ErrInvalidVoteValue)
+	})
+
+	t.Run("ranking", func(t *testing.T) {
+		order, err := domain.PollTypeRanking.ValidateVotes([]int{2, 0, 1}, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 0, 1}, order)
+
+		_, err = domain.PollTypeRanking.ValidateVotes([]int{2, 0}, 3)
+		assert.ErrorIs(t, err, domain.ErrIncompleteRanking)
+
+		_, err = domain.PollTypeRanking.ValidateVotes([]int{2, 2, 1}, 3)
+		assert.ErrorIs(t, err, domain.ErrIncompleteRanking)
+
+		_, err = domain.PollTypeRanking.ValidateVotes([]int{3, 0, 1}, 3)
+		assert.ErrorIs(t, err, domain.ErrVoteOutOfRange)
+	})
+
+	t.Run("opinion", func(t *testing.T) {
+		_, err := domain.PollTypeOpinion.ValidateVotes([]int{1}, 1)
+		assert.ErrorIs(t, err, domain.ErrUnsupportedBallot)
+	})
+
+}
+
+func TestRankingAggregates(t *testing.T) {
+	poll := domain.Poll{PollType: domain.PollTypeRanking, Options: []string{"A", "B", "C"}}
+	poll.ResetAggregates()
+
+	poll.ApplyBallot(domain.Ballot{Options: []int{0, 1, 2}})
+	poll.ApplyBallot(domain.Ballot{Options: []int{1, 0, 2}})
+
+	assert.Equal(t, 2, poll.Participant)
+	assert.Equal(t, []int{1, 1, 0}, poll.Votes)
+	assert.Equal(t, []int{3, 3, 0}, poll.Ranking.BordaScores)
+	assert.Equal(t, []float64{1.5, 1.5, 3}, poll.AverageRankPositions())
+}
+
+func TestApplyBallot(t *testing.T) {
+	correct, wrong := true, false
+	value := 42.0
+
+	tests := []struct {
+		name    string
+		poll    domain.Poll
+		ballots []domain.Ballot
+		check   func(t *testing.T, poll domain.Poll)
+	}{
+		{
+			name:    "single choice",
+			poll:    domain.Poll{PollType: domain.PollTypeSingleChoice, Options: []string{"A", "B"}},
+			ballots: []domain.Ballot{{Options: []int{0}}, {Options: []int{1}}, {Options: []int{0}}},
+			check: func(t *testing.T, poll domain.Poll) {
+				assert.Equal(t, 3, poll.Participant)
+				assert.Equal(t, []int{2, 1}, poll.Votes)
+			},
+		},
+		{
+			name:    "out of range options are ignored",
+			poll:    domain.Poll{PollType: domain.PollTypeMultiChoice, Options: []string{"A", "B"}},
+			ballots: []domain.Ballot{{Options: []int{0, 1}}, {Options: []int{1, 5}}},
+			check: func(t *testing.T, poll domain.Poll) {
+				assert.Equal(t, 2, poll.Participant)
+				assert.Equal(t, []int{1, 2}, poll.Votes)
+			},
+		},
+		{
+			name:    "opinion",
+			poll:    domain.Poll{PollType: domain.PollTypeOpinion},
+			ballots: []domain.Ballot{{Text: []string{"Great"}}, {Text: []string{"Too long", "Loud"}}},
+			check: func(t *testing.T, poll domain.Poll) {
+				assert.Equal(t, 2, poll.Participant)
+				assert.Equal(t, []string{"Great", "Too long", "Loud"}, poll.Responses)
+			},
+		},
+		{
+			name:    "other",
+			poll:    domain.Poll{PollType: domain.PollTypeSingleChoice, Options: domain.WithOtherOption([]string{"A"}), AllowOther: true},
+			ballots: []domain.Ballot{{Options: []int{1}, Text: []string{"Mine"}}, {Options: []int{0}}},
+			check: func(t *testing.T, poll domain.Poll) {
+				assert.Equal(t, []int{1, 1}, poll.Votes)
+				assert.Equal(t, []string{"Mine"}, poll.OtherResponses)
+				assert.Empty(t, poll.Responses)
+			},
+		},
+		{
+			name:    "matrix",
+			poll:    domain.Poll{PollType: domain.PollTypeMatrix, Options: []string{"Bad", "Good"}, Rows: []string{"Food", "Music"}},
+			ballots: []domain.Ballot{{Options: []int{1, 0}}, {Options: []int{1, 1}}},
+			check: func(t *testing.T, poll domain.Poll) {
+				assert.Equal(t, 2, poll.Participant)
+				assert.Equal(t, [][]int{{0, 2}, {1, 1}}, poll.MatrixVotes)
+			},
+		},
+		{
+			name:    "slide",
+			poll:    domain.Poll{PollType: domain.PollTypeSlide, Slide: &domain.SlideConfig{Min: 0, Max: 100, Step: 1}},
+			ballots: []domain.Ballot{{Value: &value}},
+			check: func(t *testing.T, poll domain.Poll) {
+				assert.Equal(t, 1, poll.Participant)
+				assert.Equal(t, []float64{value}, poll.SlideValues)
+			},
+		},
+		{
+			name:    "graded",
+			poll:    domain.Poll{PollType: domain.PollTypeSingleChoice, Options: []string{"A", "B"}, CorrectOptions: []int{0}},
+			ballots: []domain.Ballot{{Options: []int{0}, Correct: &correct}, {Options: []int{1}, Correct: &wrong}, {Options: []int{0}, Correct: &correct}},
+			check: func(t *testing.T, poll domain.Poll) {
+				assert.Equal(t, 3, poll.Participant)
+				assert.Equal(t, 2, poll.CorrectCount)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			poll := tc.poll
+			poll.ResetAggregates()
+			for _, ballot := range tc.ballots {
+				poll.ApplyBallot(ballot)
+			}
+
+			// A recount starts over, dropping the counters built so far.
+			poll.ResetAggregates()
+			for _, ballot := range tc.ballots {
+				poll.ApplyBallot(ballot)
+			}
+			tc.check(t, poll)
+		})
+	}
+}
+
+func TestSameShape(t *testing.T) {
+	poll := domain.Poll{PollType: domain.PollTypeSingleChoice, Options: []string{"A", "B"}}
+
+	assert.True(t, poll.SameShape(domain.Poll{PollType: domain.PollTypeSingleChoice, Options: []string{"A", "B"}, Title: "Renamed"}))
+	assert.False(t, poll.SameShape(domain.Poll{PollType: domain.PollTypeMultiChoice, Options: []string{"A", "B"}}))
+	assert.False(t, poll.SameShape(domain.Poll{PollType: domain.PollTypeSingleChoice, Options: []string{"B", "A"}}))
+	assert.False(t, poll.SameShape(domain.Poll{PollType: domain.PollTypeSingleChoice, Options: []string{"A", "B"}, Rows: []string{"Row"}}))
+
+	graded := domain.Poll{PollType: domain.PollTypeSingleChoice, Options: []string{"A", "B"}, CorrectOptions: []int{0}, Points: 1}
+	assert.True(t, graded.SameShape(domain.Poll{PollType: domain.PollTypeSingleChoice, Options: []string{"A", "B"}, CorrectOptions: []int{0}, Points: 1}))
+	assert.False(t, graded.SameShape(domain.Poll{PollType: domain.PollTypeSingleChoice, Options: []string{"A", "B"}, CorrectOptions: []int{1}, Points: 1}))
+	assert.False(t, graded.SameShape(domain.Poll{PollType: domain.PollTypeSingleChoice, Options: []string{"A", "B"}, CorrectOptions: []int{0}, Points: 2}))
+	assert.False(t, graded.SameShape(poll))
+}
+
+func TestNumericStatistics(t *testing.T) {
+
+	t.Run("rating", func(t *testing.T) {
+		scale, err := domain.PollTypeRating.ResolveScale(&domain.ScaleConfig{Min: 1, Max: 5, Step: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "3", "5"}, scale.OptionLabels())
+
+		poll := domain.Poll{PollType: domain.PollTypeRating, Scale: scale, Options: scale.OptionLabels(), Votes: []int{1, 2, 1}}
+		stats := poll.NumericStatistics()
+		assert.Equal(t, 4, stats.Count)
+		assert.InDelta(t, 3, stats.Mean, 0.001)
+		assert.InDelta(t, 3, stats.Median, 0.001)
+		assert.InDelta(t, 1.414, stats.StdDev, 0.001)
+		assert.Nil(t, poll.NPSBreakdown())
+
+		_, err = domain.PollTypeRating.ResolveScale(&domain.ScaleConfig{Min: 1, Max: 4, Step: 2})
+		assert.ErrorIs(t, err, domain.ErrInvalidScale)
+	})
+
+	t.Run("nps", func(t *testing.T) {
+		scale, err := domain.PollTypeNPS.ResolveScale(nil)
+		assert.NoError(t, err)
+		assert.Len(t, scale.OptionLabels(), 11)
+
+		votes := make([]int, 11)
+		votes[10], votes[9], votes[7], votes[3] = 2, 1, 1, 1
+		poll := domain.Poll{PollType: domain.PollTypeNPS, Scale: scale, Votes: votes}
+
+		breakdown := poll.NPSBreakdown()
+		assert.Equal(t, 3, breakdown.Promoters)
+		assert.Equal(t, 1, breakdown.Passives)
+		assert.Equal(t, 1, breakdown.Detractors)
+		assert.InDelta(t, 40, breakdown.Score, 0.001)
+	})
+
+}
+
+func TestSlideConfig(t *testing.T) {
+	slide, err := domain.PollTypeSlide.ResolveSlide(&domain.SlideConfig{Min: 0, Max: 50, Step: 5, Unit: "km"})
+	assert.NoError(t, err)
+	assert.Equal(t, 6, slide.BucketCount())
+	assert.Equal(t, "0 km - 5 km", slide.BucketLabels()[0])
+	assert.Equal(t, "50 km", slide.BucketLabels()[5])
+
+	value := 35.0
+	accepted, err := slide.ValidateValue(&value)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, slide.Bucket(accepted))
+
+	offStep := 12.0
+	_, err = slide.ValidateValue(&offStep)
+	assert.ErrorIs(t, err, domain.ErrSlideValueOffStep)
+
+	outside := 60.0
+	_, err = slide.ValidateValue(&outside)
+	assert.ErrorIs(t, err, domain.ErrSlideValueOutOfRange)
+
+	_, err = slide.ValidateValue(nil)
+	assert.ErrorIs(t, err, domain.ErrSlideValueRequired)
+
+	poll := domain.Poll{PollType: domain.PollTypeSlide, Slide: slide, Options: slide.BucketLabels()}
+	poll.ResetAggregates()
+	for _, v := range []float64{10, 20, 30} {
+		v := v
+		poll.ApplyBallot(domain.Ballot{Value: &v})
+	}
+	assert.Equal(t, []int{0, 1, 1, 1, 0, 0}, poll.Votes)
+	stats := poll.NumericStatistics()
+	assert.InDelta(t, 20, stats.Mean, 0.001)
+	assert.InDelta(t, 20, stats.Median, 0.001)
+}
+
+func TestValidateMatrix(t *testing.T) {
+	columns, err := domain.ValidateMatrix([][]int{{0, 1, 0}, {1, 0, 0}}, 2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 0}, columns)
+
+	_, err = domain.ValidateMatrix([][]int{{0, 1, 0}}, 2, 3)
+	assert.ErrorIs(t, err, domain.ErrMatrixIncomplete)
+
+	_, err = domain.ValidateMatrix([][]int{{0, 1, 0}, {0, 0, 0}}, 2, 3)
+	assert.ErrorIs(t, err, domain.ErrMatrixIncomplete)
+
+	_, err = domain.ValidateMatrix([][]int{{1, 1, 0}, {1, 0, 0}}, 2, 3)
+	assert.ErrorIs(t, err, domain.ErrTooManySelections)
+
+	poll := domain.Poll{PollType: domain.PollTypeMatrix, Rows: []string{"Sound", "Seating"}, Options: []string{"Bad", "Ok", "Good"}}
+	poll.ResetAggregates()
+	poll.ApplyBallot(domain.Ballot{Options: columns})
+	assert.Equal(t, [][]int{{0, 1, 0}, {1, 0, 0}}, poll.MatrixVotes)
+}
+
+func TestQuizScoring(t *testing.T) {
+	correctOptions, points, err := domain.PollTypeMultiChoice.ResolveCorrectOptions([]int{2, 0}, 0, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 2}, correctOptions)
+	assert.Equal(t, 1, points)
+
+	_, _, err = domain.PollTypeSingleChoice.ResolveCorrectOptions([]int{0, 1}, 1, 3)
+	assert.ErrorIs(t, err, domain.ErrSingleCorrectOption)
+
+	_, _, err = domain.PollTypeOpinion.ResolveCorrectOptions([]int{0}, 1, 1)
+	assert.ErrorIs(t, err, domain.ErrCorrectOptionsNotSupported)
+
+	_, _, err = domain.PollTypeMultiChoice.ResolveCorrectOptions([]int{3}, 1, 3)
+	assert.ErrorIs(t, err, domain.ErrInvalidCorrectOptions)
+
+	_, _, err = domain.PollTypeMultiChoice.ResolveCorrectOptions([]int{0}, -1, 3)
+	assert.ErrorIs(t, err, domain.ErrNegativePoints)
+
+	first := domain.Poll{ID: primitive.NewObjectID(), PollType: domain.PollTypeMultiChoice, Options: []string{"a", "b", "c"}, CorrectOptions: correctOptions, Points: 2}
+	second := domain.Poll{ID: primitive.NewObjectID(), PollType: domain.PollTypeOpinion}
+	assert.True(t, first.Grade([]int{2, 0}))
+	assert.False(t, first.Grade([]int{0}))
+
+	correct := true
+	incorrect := false
+	now := time.Now()
+	ballots := []domain.Ballot{
+		{PollID: first.ID, RespondentKey: "alice", Correct: &correct, Points: 2, CreatedAt: now},
+		{PollID: first.ID, RespondentKey: "bob", Correct: &incorrect, CreatedAt: now.Add(-time.Minute)},
+	}
+
+	assert.Nil(t, domain.ScoreQuiz([]domain.Poll{first, second}, ballots[:1]))
+
+	score := domain.ScoreQuiz([]domain.Poll{first, second}, append(ballots[:1:1], domain.Ballot{PollID: second.ID, RespondentKey: "alice"}))
+	assert.Equal(t, &domain.QuizScore{Score: 2, MaxScore: 2, Correct: 1, Questions: 1}, score)
+
+	leaderboard := domain.BuildLeaderboard(ballots)
+	assert.Len(t, leaderboard, 2)
+	assert.Equal(t, "alice", leaderboard[0].RespondentKey)
+	assert.Equal(t, 1, leaderboard[0].Rank)
+	assert.Equal(t, 0, leaderboard[1].Score)
+}
+
+func TestQuizScoringWithHiddenQuestion(t *testing.T) {
+	gate := domain.Poll{ID: primitive.NewObjectID(), PollType: domain.PollTypeSingleChoice, Options: []string{"Yes", "No"}, CorrectOptions: []int{1}, Points: 1}
+	followUp := domain.Poll{
+		ID:             primitive.NewObjectID(),
+		PollType:       domain.PollTypeSingleChoice,
+		Options:        []string{"a", "b"},
+		CorrectOptions: []int{0},
+		Points:         3,
+		ShowIf:         &domain.DisplayRule{PollID: gate.ID, Options: []int{0}},
+	}
+	polls := []domain.Poll{gate, followUp}
+
+	correct := true
+	hidden := []domain.Ballot{{PollID: gate.ID, Options: []int{1}, Correct: &correct, Points: 1}}
+	assert.Equal(t, &domain.QuizScore{Score: 1, MaxScore: 1, Correct: 1, Questions: 1}, domain.ScoreQuiz(polls, hidden))
+
+	incorrect := false
+	shown := []domain.Ballot{{PollID: gate.ID, Options: []int{0}, Correct: &incorrect}}
+	assert.Nil(t, domain.ScoreQuiz(polls, shown))
+
+	shown = append(shown, domain.Ballot{PollID: followUp.ID, Options: []int{0}, Correct: &correct, Points: 3})
+	assert.Equal(t, &domain.QuizScore{Score: 3, MaxScore: 4, Correct: 1, Questions: 2}, domain.ScoreQuiz(polls, shown))
+}
+
+func TestOtherOption(t *testing.T) {
+	assert.True(t, domain.PollTypeMultiChoice.SupportsOther())
+	assert.False(t, domain.PollTypeRanking.SupportsOther())
+
+	options := domain.WithOtherOption([]string{"a", "b"})
+	assert.Equal(t, []string{"a", "b", domain.OtherOptionLabel}, options)
+	assert.Equal(t, []string{"a", domain.OtherOptionLabel, domain.OtherOptionLabel}, domain.WithOtherOption([]string{"a", domain.OtherOptionLabel}))
+	assert.Equal(t, []string{"a", "b"}, domain.WithoutOtherOption(options))
+	assert.Equal(t, []string{"a", "b"}, domain.WithoutOtherOption([]string{"a", "b"}))
+
+	poll := domain.Poll{PollType: domain.PollTypeMultiChoice, Options: options, AllowOther: true}
+	assert.Equal(t, 2, poll.OtherIndex())
+
+	text, err := poll.OtherText([]int{0}, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, text)
+
+	_, err = poll.OtherText([]int{0, 2}, []string{"  "})
+	assert.ErrorIs(t, err, domain.ErrOtherTextRequired)
+
+	text, err = poll.OtherText([]int{2}, []string{" something else "})
+	assert.NoError(t, err)
+	assert.Equal(t, "something else", text)
+
+	poll.ResetAggregates()
+	poll.ApplyBallot(domain.Ballot{Options: []int{2}, Text: []string{text}})
+	assert.Equal(t, []int{0, 0, 1}, poll.Votes)
+	assert.Equal(t, []string{"something else"}, poll.OtherResponses)
+	assert.Empty(t, poll.Responses)
+}
+
+func TestSelectionLimits(t *testing.T) {
+	minSelections, maxSelections, err := domain.PollTypeMultiChoice.ResolveSelectionLimits(1, 3, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, minSelections)
+	assert.Equal(t, 3, maxSelections)
+
+	_, _, err = domain.PollTypeSingleChoice.ResolveSelectionLimits(0, 1, 4)
+	assert.ErrorIs(t, err, domain.ErrInvalidSelectionLimits)
+	_, _, err = domain.PollTypeMultiChoice.ResolveSelectionLimits(3, 2, 4)
+	assert.ErrorIs(t, err, domain.ErrInvalidSelectionLimits)
+	_, _, err = domain.PollTypeMultiChoice.ResolveSelectionLimits(0, 5, 4)
+	assert.ErrorIs(t, err, domain.ErrInvalidSelectionLimits)
+
+	poll := domain.Poll{PollType: domain.PollTypeMultiChoice, MinSelections: 2, MaxSelections: 3}
+	assert.NoError(t, poll.ValidateSelectionCount([]int{0, 1}))
+	assert.ErrorIs(t, poll.ValidateSelectionCount([]int{0}), domain.ErrTooFewSelections)
+	assert.ErrorIs(t, poll.ValidateSelectionCount([]int{0, 1, 2, 3}), domain.ErrTooManySelections)
+	assert.Equal(t, "2 to 3", poll.SelectionLimitLabel())
+	assert.Empty(t, domain.Poll{}.SelectionLimitLabel())
+}
+
+func TestDisplayRules(t *testing.T) {
+	newPolls := func() []domain.Poll {
+		return []domain.Poll{
+			{ID: primitive.NewObjectID(), PollType: domain.PollTypeSingleChoice, Options: []string{"yes", "no"}},
+			{ID: primitive.NewObjectID(), PollType: domain.PollTypeOpinion, Options: []string{"why?"}},
+			{ID: primitive.NewObjectID(), PollType: domain.PollTypeMultiChoice, Options: []string{"a", "b"}},
+		}
+	}
+
+	polls := newPolls()
+	err := domain.LinkDisplayRules(polls, []*domain.SheetDisplayRule{nil, {Poll: 1, Options: []int{1}}, nil})
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.DisplayRule{PollID: polls[0].ID, Options: []int{1}}, polls[1].ShowIf)
+
+	assert.False(t, polls[1].IsVisible(nil))
+	assert.False(t, polls[1].IsVisible(map[primitive.ObjectID][]int{polls[0].ID: {0}}))
+	assert.True(t, polls[1].IsVisible(map[primitive.ObjectID][]int{polls[0].ID: {1}}))
+	assert.True(t, polls[0].IsVisible(nil))
+
+	err = domain.LinkDisplayRules(newPolls(), []*domain.SheetDisplayRule{{Poll: 4, Options: []int{0}}})
+	assert.ErrorIs(t, err, domain.ErrDanglingDisplayRule)
+
+	err = domain.LinkDisplayRules(newPolls(), []*domain.SheetDisplayRule{nil, nil, {Poll: 2, Options: []int{0}}})
+	assert.ErrorIs(t, err, domain.ErrDisplayRuleSource)
+
+	err = domain.LinkDisplayRules(newPolls(), []*domain.SheetDisplayRule{{Poll: 1, Options: []int{0}}})
+	assert.ErrorIs(t, err, domain.ErrSelfDisplayRule)
+
+	err = domain.LinkDisplayRules(newPolls(), []*domain.SheetDisplayRule{nil, {Poll: 1, Options: []int{2}}})
+	assert.ErrorIs(t, err, domain.ErrDisplayRuleOptions)
+
+	err = domain.LinkDisplayRules(newPolls(), []*domain.SheetDisplayRule{{Poll: 3, Options: []int{0}}, nil, {Poll: 1, Options: []int{0}}})
+	assert.ErrorIs(t, err, domain.ErrDisplayRuleCycle)
+}
+
+func TestCheckRequiredPolls(t *testing.T) {
+	trigger := domain.Poll{ID: primitive.NewObjectID(), PollType: domain.PollTypeSingleChoice, Options: []string{"yes", "no"}, Required: true}
+	followUp := domain.Poll{ID: primitive.NewObjectID(), PollType: domain.PollTypeOpinion, Required: true, ShowIf: &domain.DisplayRule{PollID: trigger.ID, Options: []int{1}}}
+	optional := domain.Poll{ID: primitive.NewObjectID(), PollType: domain.PollTypeOpinion}
+	polls := []domain.Poll{trigger, followUp, optional}
+
+	err := domain.CheckRequiredPolls(polls, map[primitive.ObjectID][]int{})
+	var missing *domain.MissingRequiredPollsError
+	assert.ErrorAs(t, err, &missing)
+	assert.ErrorIs(t, err, domain.ErrInvalidBallot)
+	assert.Equal(t, []string{trigger.ID.Hex()}, missing.PollIDs)
+
+	assert.NoError(t, domain.CheckRequiredPolls(polls, map[primitive.ObjectID][]int{trigger.ID: {0}}))
+
+	err = domain.CheckRequiredPolls(polls, map[primitive.ObjectID][]int{trigger.ID: {1}})
+	assert.ErrorAs(t, err, &missing)
+	assert.Equal(t, []string{followUp.ID.Hex()}, missing.PollIDs)
+}
+
+func TestReorderPolls(t *testing.T) {
+	first := domain.Poll{ID: primitive.NewObjectID(), Position: 1}
+	second := domain.Poll{ID: primitive.NewObjectID(), Position: 2}
+	polls := []domain.Poll{first, second}
+
+	reordered, err := domain.ReorderPolls(polls, []string{second.ID.Hex(), first.ID.Hex()})
+	assert.NoError(t, err)
+	assert.Equal(t, second.ID, reordered[0].ID)
+	assert.Equal(t, 1, reordered[0].Position)
+	assert.Equal(t, 2, reordered[1].Position)
+
+	_, err = domain.ReorderPolls(polls, []string{first.ID.Hex()})
+	assert.ErrorIs(t, err, domain.ErrInvalidPollOrder)
+
+	_, err = domain.ReorderPolls(polls, []string{first.ID.Hex(), first.ID.Hex()})
+	assert.ErrorIs(t, err, domain.ErrInvalidPollOrder)
+}
+
+func TestShuffleOptions(t *testing.T) {
+	poll := domain.Poll{
+		ID:             primitive.NewObjectID(),
+		PollType:       domain.PollTypeMultiChoice,
+		Options:        domain.WithOtherOption([]string{"a", "b", "c", "d", "e"}),
+		AllowOther:     true,
+		ShuffleOptions: true,
+	}
+
+	perm := poll.OptionPermutation("respondent")
+	assert.Equal(t, perm, poll.OptionPermutation("respondent"))
+	assert.Len(t, perm, len(poll.Options))
+	assert.Equal(t, poll.OtherIndex(), perm[len(perm)-1])
+	assert.ElementsMatch(t, []int{0, 1, 2, 3, 4, 5}, perm)
+	assert.Nil(t, poll.OptionPermutation(""))
+
+	displayed := domain.PermuteOptions(poll.Options, perm)
+	assert.Equal(t, domain.OtherOptionLabel, displayed[len(displayed)-1])
+
+	votes := make([]int, len(perm))
+	votes[0] = 1
+	answer, err := poll.CanonicalAnswer(domain.PollClientAnswer{Votes: votes}, "respondent")
+	assert.NoError(t, err)
+	selected, err := poll.PollType.ValidateVotes(answer.Votes, len(poll.Options))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{perm[0]}, selected)
+	assert.Equal(t, []int{0}, domain.DisplayIndices(selected, perm))
+
+	_, err = poll.CanonicalAnswer(domain.PollClientAnswer{Votes: []int{1}}, "respondent")
+	assert.ErrorIs(t, err, domain.ErrShuffledVoteLength)
+	assert.ErrorIs(t, err, domain.ErrInvalidBallot)
+
+	ranked := domain.Poll{ID: poll.ID, PollType: domain.PollTypeRanking, Options: []string{"a", "b", "c"}, ShuffleOptions: true}
+	rankPerm := ranked.OptionPermutation("respondent")
+	answer, err = ranked.CanonicalAnswer(domain.PollClientAnswer{Votes: []int{2, 0, 1}}, "respondent")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{rankPerm[2], rankPerm[0], rankPerm[1]}, answer.Votes)
+
+	scale := domain.Poll{ID: poll.ID, PollType: domain.PollTypeRating, Options: []string{"1", "2"}, ShuffleOptions: true}
+	assert.Nil(t, scale.OptionPermutation("respondent"))
+
+	polls := []domain.Poll{{Title: "a"}, {Title: "b"}, {Title: "c"}}
+	shuffled := domain.ShufflePolls(polls, "sheet", "respondent")
+	assert.Equal(t, shuffled, domain.ShufflePolls(polls, "sheet", "respondent"))
+	assert.ElementsMatch(t, polls, shuffled)
+}
+
+func TestOptionMedia(t *testing.T) {
+	assert.True(t, domain.ValidMediaKey(primitive.NewObjectID().Hex()+".png"))
+	assert.False(t, domain.ValidMediaKey("../secret.png"))
+	assert.False(t, domain.ValidMediaKey(primitive.NewObjectID().Hex()+".exe"))
+
+	extension, ok := domain.MediaExtension("image/jpeg")
+	assert.True(t, ok)
+	assert.Equal(t, ".jpg", extension)
+	_, ok = domain.MediaExtension("text/html")
+	assert.False(t, ok)
+
+	poll := domain.Poll{Options: []string{"a", "b", "c"}}
+	assert.Nil(t, poll.OptionMediaURLs())
+
+	media, replaced := poll.WithOptionMedia(domain.OptionMedia{Option: 1, Key: "first.png"})
+	assert.Nil(t, replaced)
+	poll.OptionMedia = media
+
+	media, replaced = poll.WithOptionMedia(domain.OptionMedia{Option: 1, Key: "second.png"})
+	assert.Equal(t, "first.png", replaced.Key)
+	poll.OptionMedia = media
+
+	assert.Equal(t, []string{"", domain.MediaURL("second.png"), ""}, poll.OptionMediaURLs())
+
+	attachments := []domain.OptionMedia{{Option: 0, Key: "a.png"}, {Option: 1, Key: "b.png"}, {Option: 2, Key: "c.png"}}
+	kept, dropped := domain.RemapOptionMedia(attachments, []string{"a", "b", "c"}, []string{"c", "a", "d"})
+	assert.Equal(t, []domain.OptionMedia{{Option: 1, Key: "a.png"}, {Option: 0, Key: "c.png"}}, kept)
+	assert.Equal(t, []domain.OptionMedia{{Option: 1, Key: "b.png"}}, dropped)
+
+	kept, dropped = domain.RemapOptionMedia(nil, []string{"a"}, []string{"b"})
+	assert.Empty(t, kept)
+	assert.NotNil(t, kept)
+	assert.Empty(t, dropped)
+}
@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const defaultQuizPoints = 1
+
+var (
+	ErrInvalidCorrectOptions      = errors.New("invalid correct options")
+	ErrCorrectOptionsNotSupported = fmt.Errorf("%w: correct options are only supported for single and multi choice polls", ErrInvalidCorrectOptions)
+	ErrSingleCorrectOption        = fmt.Errorf("%w: single choice polls accept exactly one correct option", ErrInvalidCorrectOptions)
+	ErrCorrectOptionOutOfRange    = fmt.Errorf("%w: correct option does not exist", ErrInvalidCorrectOptions)
+	ErrNegativePoints             = errors.New("points cannot be negative")
+)
+
+// QuizScore is returned to a respondent once they have answered every poll of a quiz sheet.
+type QuizScore struct {
+	Score     int `json:"score"`
+	MaxScore  int `json:"max_score"`
+	Correct   int `json:"correct"`
+	Questions int `json:"questions"`
+}
+
+type QuizLeaderboardEntry struct {
+	Rank          int       `json:"rank"`
+	RespondentKey string    `json:"respondent_key"`
+	Phone         string    `json:"phone,omitempty"`
+	Score         int       `json:"score"`
+	Correct       int       `json:"correct"`
+	Answered      int       `json:"answered"`
+	CompletedAt   time.Time `json:"completed_at"`
+}
+
+// ResolveCorrectOptions validates the correct answers configured for a poll and returns
+// the points a correct answer is worth. Polls without correct options are not graded.
+func (t PollType) ResolveCorrectOptions(correct []int, points int, optionCount int) ([]int, int, error) {
+	if len(correct) == 0 {
+		return nil, 0, nil
+	}
+
+	switch t {
+	case singleChoice:
+		if len(correct) != 1 {
+			return nil, 0, ErrSingleCorrectOption
+		}
+	case multiChoice:
+	default:
+		return nil, 0, ErrCorrectOptionsNotSupported
+	}
+
+	seen := make(map[int]struct{}, len(correct))
+	normalized := make([]int, 0, len(correct))
+	for _, option := range correct {
+		if option < 0 || option >= optionCount {
+			return nil, 0, ErrCorrectOptionOutOfRange
+		}
+		if _, ok := seen[option]; ok {
+			continue
+		}
+		seen[option] = struct{}{}
+		normalized = append(normalized, option)
+	}
+	sort.Ints(normalized)
+
+	if points < 0 {
+		return nil, 0, ErrNegativePoints
+	}
+	if points == 0 {
+		points = defaultQuizPoints
+	}
+
+	return normalized, points, nil
+}
+
+// IsGradable reports whether the poll has correct answers configured.
+func (p Poll) IsGradable() bool {
+	return len(p.CorrectOptions) > 0 && (p.PollType == singleChoice || p.PollType == multiChoice)
+}
+
+// Grade reports whether the selected options match the correct options exactly.
+func (p Poll) Grade(selected []int) bool {
+	if len(selected) != len(p.CorrectOptions) {
+		return false
+	}
+
+	correct := make(map[int]struct{}, len(p.CorrectOptions))
+	for _, option := range p.CorrectOptions {
+		correct[option] = struct{}{}
+	}
+	for _, option := range selected {
+		if _, ok := correct[option]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// CorrectRate returns the share of respondents who answered a graded poll correctly.
+func (p Poll) CorrectRate() *float64 {
+	if !p.IsGradable() {
+		return nil
+	}
+
+	rate := 0.0
+	if p.Participant > 0 {
+		rate = float64(p.CorrectCount) / float64(p.Participant)
+	}
+	return &rate
+}
+
+// ScoreQuiz totals a respondent's ballots for a quiz sheet. It returns nil until the
+// respondent has answered every poll shown to them; polls hidden by their display rule
+// are left out of the score.
+func ScoreQuiz(polls []Poll, ballots []Ballot) *QuizScore {
+	answered := make(map[primitive.ObjectID]Ballot, len(ballots))
+	selections := make(map[primitive.ObjectID][]int, len(ballots))
+	for _, ballot := range ballots {
+		answered[ballot.PollID] = ballot
+		selections[ballot.PollID] = ballot.Options
+	}
+
+	score := QuizScore{}
+	for _, poll := range polls {
+		if !poll.IsVisible(selections) {
+			continue
+		}
+		ballot, ok := answered[poll.ID]
+		if !ok {
+			return nil
+		}
+		if !poll.IsGradable() {
+			continue
+		}
+
+		score.Questions++
+		score.MaxScore += poll.Points
+		if ballot.Correct != nil && *ballot.Correct {
+			score.Correct++
+			score.Score += ballot.Points
+		}
+	}
+
+	return &score
+}
+
+// BuildLeaderboard ranks respondents by their graded ballots, highest score first and the
+// earliest finisher breaking ties. Ungraded ballots are ignored.
+func BuildLeaderboard(ballots []Ballot) []QuizLeaderboardEntry {
+	entries := make(map[string]*QuizLeaderboardEntry)
+	order := make([]string, 0)
+
+	for _, ballot := range ballots {
+		if ballot.Correct == nil {
+			continue
+		}
+
+		entry, ok := entries[ballot.RespondentKey]
+		if !ok {
+			entry = &QuizLeaderboardEntry{RespondentKey: ballot.RespondentKey}
+			entries[ballot.RespondentKey] = entry
+			order = append(order, ballot.RespondentKey)
+		}
+
+		entry.Answered++
+		if *ballot.Correct {
+			entry.Correct++
+			entry.Score += ballot.Points
+		}
+		if entry.Phone == "" {
+			entry.Phone = ballot.Phone
+		}
+		if ballot.CreatedAt.After(entry.CompletedAt) {
+			entry.CompletedAt = ballot.CreatedAt
+		}
+	}
+
+	leaderboard := make([]QuizLeaderboardEntry, 0, len(order))
+	for _, key := range order {
+		leaderboard = append(leaderboard, *entries[key])
+	}
+
+	sort.SliceStable(leaderboard, func(i, j int) bool {
+		if leaderboard[i].Score != leaderboard[j].Score {
+			return leaderboard[i].Score > leaderboard[j].Score
+		}
+		return leaderboard[i].CompletedAt.Before(leaderboard[j].CompletedAt)
+	})
+
+	for idx := range leaderboard {
+		leaderboard[idx].Rank = idx + 1
+	}
+
+	return leaderboard
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -18,6 +20,76 @@ const (
 	SheetStatusFinished  SheetStatus = "finished"
 )
 
+// SheetCloseReason records what moved a sheet to SheetStatusFinished.
+type SheetCloseReason string
+
+const (
+	SheetClosedManually   SheetCloseReason = "manual"
+	SheetClosedBySchedule SheetCloseReason = "schedule"
+)
+
+var (
+	ErrSheetNotApproved = errors.New("sheet is not yet approved")
+	ErrSheetNotOpenYet  = errors.New("sheet is not open yet")
+	ErrSheetClosed      = errors.New("sheet is closed")
+	ErrSheetRejected    = errors.New("sheet was rejected")
+)
+
+// Error codes returned alongside the sheet lifecycle errors so clients can tell them apart.
+const (
+	ErrorCodeSheetNotApproved = "sheet_not_approved"
+	ErrorCodeSheetNotOpenYet  = "sheet_not_open"
+	ErrorCodeSheetClosed      = "sheet_closed"
+	ErrorCodeSheetRejected    = "sheet_rejected"
+)
+
+// AcceptsResponses reports whether respondents may view and answer the sheet at now.
+// Only published sheets inside their optional OpensAt/ClosesAt window are open; every
+// other state maps to a distinct error.
+func (s Sheet) AcceptsResponses(now time.Time) error {
+	switch s.Status {
+	case SheetStatusPublished:
+		if s.OpensAt != nil && now.Before(*s.OpensAt) {
+			return ErrSheetNotOpenYet
+		}
+		if s.ClosesAt != nil && !now.Before(*s.ClosesAt) {
+			return ErrSheetClosed
+		}
+		return nil
+	case SheetStatusFinished:
+		return ErrSheetClosed
+	case SheetStatusRejected:
+		return ErrSheetRejected
+	default:
+		return ErrSheetNotApproved
+	}
+}
+
+// DedupMode selects how a sheet recognises repeat submissions from the same respondent.
+type DedupMode string
+
+const (
+	DedupNone        DedupMode = "none"
+	DedupPhone       DedupMode = "phone"
+	DedupToken       DedupMode = "token"
+	DedupFingerprint DedupMode = "fingerprint"
+)
+
+func ParseDedupMode(value string) (DedupMode, error) {
+	switch value {
+	case "", string(DedupNone):
+		return DedupNone, nil
+	case string(DedupPhone):
+		return DedupPhone, nil
+	case string(DedupToken):
+		return DedupToken, nil
+	case string(DedupFingerprint):
+		return DedupFingerprint, nil
+	default:
+		return "", fmt.Errorf("invalid dedup mode: %s", value)
+	}
+}
+
 type Sheet struct {
 	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id"`
 	UserID          primitive.ObjectID `bson:"userID" json:"-"`
@@ -26,25 +98,52 @@ type Sheet struct {
 	Description     string             `bson:"description" form:"description" json:"description"`
 	Status          SheetStatus        `bson:"status" json:"status"`
 	IsPhoneRequired bool               `bson:"isPhoneRequired" form:"is_phone_required" json:"is_phone_required"`
+	IsQuiz          bool               `bson:"isQuiz,omitempty" json:"is_quiz"`
+	ShufflePolls    bool               `bson:"shufflePolls,omitempty" json:"shuffle_polls"`
+	DedupMode       DedupMode          `bson:"dedupMode,omitempty" json:"dedup_mode,omitempty"`
 	ApprovedBy      primitive.ObjectID `bson:"approvedBy,omitempty" json:"approved_by,omitempty"`
 	ApprovedAt      time.Time          `bson:"approvedAt,omitempty" json:"approved_at,omitempty"`
+	OpensAt         *time.Time         `bson:"opensAt,omitempty" json:"opens_at,omitempty"`
+	ClosesAt        *time.Time         `bson:"closesAt,omitempty" json:"closes_at,omitempty"`
+	ClosedBy        primitive.ObjectID `bson:"closedBy,omitempty" json:"closed_by,omitempty"`
+	ClosedAt        time.Time          `bson:"closedAt,omitempty" json:"closed_at,omitempty"`
+	CloseReason     SheetCloseReason   `bson:"closeReason,omitempty" json:"close_reason,omitempty"`
+	DeletedAt       *time.Time         `bson:"deletedAt,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy       primitive.ObjectID `bson:"deletedBy,omitempty" json:"deleted_by,omitempty"`
 	CreatedAt       time.Time          `bson:"createdAt" json:"-"`
 	UpdatedAt       time.Time          `bson:"updatedAt" json:"-"`
 }
 
 type SheetCreatePoll struct {
-	Title       string   `json:"title" form:"title"`
-	Description string   `json:"description,omitempty" form:"description"`
-	Options     []string `json:"options" form:"options"`
-	PollType    string   `json:"poll_type" form:"poll_type"`
-	Category    []string `json:"category" form:"category"`
+	Title          string            `bson:"title,omitempty" json:"title" form:"title"`
+	Description    string            `bson:"description,omitempty" json:"description,omitempty" form:"description"`
+	Options        []string          `bson:"options,omitempty" json:"options" form:"options"`
+	Rows           []string          `bson:"rows,omitempty" json:"rows,omitempty" form:"rows"`
+	PollType       string            `bson:"pollType,omitempty" json:"poll_type" form:"poll_type"`
+	Required       bool              `bson:"required,omitempty" json:"required,omitempty" form:"required"`
+	ShuffleOptions bool              `bson:"shuffleOptions,omitempty" json:"shuffle_options,omitempty" form:"shuffle_options"`
+	Category       []string          `bson:"category,omitempty" json:"category" form:"category"`
+	Scale          *ScaleConfig      `bson:"scale,omitempty" json:"scale,omitempty" form:"-"`
+	Slide          *SlideConfig      `bson:"slide,omitempty" json:"slide,omitempty" form:"-"`
+	CorrectOptions []int             `bson:"correctOptions,omitempty" json:"correct_options,omitempty" form:"correct_options"`
+	Points         int               `bson:"points,omitempty" json:"points,omitempty" form:"points"`
+	AllowOther     bool              `bson:"allowOther,omitempty" json:"allow_other,omitempty" form:"allow_other"`
+	MinSelections  int               `bson:"minSelections,omitempty" json:"min_selections,omitempty" form:"min_selections"`
+	MaxSelections  int               `bson:"maxSelections,omitempty" json:"max_selections,omitempty" form:"max_selections"`
+	ShowIf         *SheetDisplayRule `bson:"showIf,omitempty" json:"show_if,omitempty" form:"-"`
 }
 
 type SheetCreateRequest struct {
-	Title           string            `json:"title" form:"title"`
-	Venue           string            `json:"venue" form:"venue"`
-	IsPhoneRequired bool              `json:"is_phone_required" form:"is_phone_required"`
-	Polls           []SheetCreatePoll `json:"polls" form:"polls"`
+	Title           string            `bson:"title,omitempty" json:"title" form:"title"`
+	Venue           string            `bson:"venue,omitempty" json:"venue" form:"venue"`
+	Description     string            `bson:"description,omitempty" json:"description,omitempty" form:"description"`
+	IsPhoneRequired bool              `bson:"isPhoneRequired,omitempty" json:"is_phone_required" form:"is_phone_required"`
+	IsQuiz          bool              `bson:"isQuiz,omitempty" json:"is_quiz,omitempty" form:"is_quiz"`
+	ShufflePolls    bool              `bson:"shufflePolls,omitempty" json:"shuffle_polls,omitempty" form:"shuffle_polls"`
+	DedupMode       string            `bson:"dedupMode,omitempty" json:"dedup_mode,omitempty" form:"dedup_mode"`
+	OpensAt         *time.Time        `bson:"opensAt,omitempty" json:"opens_at,omitempty" form:"opens_at" time_format:"2006-01-02T15:04:05Z07:00"`
+	ClosesAt        *time.Time        `bson:"closesAt,omitempty" json:"closes_at,omitempty" form:"closes_at" time_format:"2006-01-02T15:04:05Z07:00"`
+	Polls           []SheetCreatePoll `bson:"polls,omitempty" json:"polls" form:"polls"`
 }
 
 type SheetCreateResponse struct {
@@ -61,6 +160,13 @@ func (r SheetCreateRequest) EffectiveTitle() string {
 	return r.Title
 }
 
+// SheetDeleteResult counts the records removed together with a sheet.
+type SheetDeleteResult struct {
+	Polls         int64 `json:"polls"`
+	Ballots       int64 `json:"ballots"`
+	Notifications int64 `json:"notifications"`
+}
+
 type SheetRepository interface {
 	Create(ctx context.Context, sheet Sheet) error
 	GetAll(ctx context.Context, pagination PaginationQuery) ([]Sheet, int64, error)
@@ -68,13 +174,31 @@ type SheetRepository interface {
 	Delete(ctx context.Context, id string) error
 	GetByID(ctx context.Context, id string) (Sheet, error)
 	UpdateStatus(ctx context.Context, id string, status SheetStatus, approvedBy primitive.ObjectID, approvedAt time.Time) error
+	Close(ctx context.Context, id string, closedBy primitive.ObjectID, reason SheetCloseReason, closedAt time.Time) error
+	CloseExpired(ctx context.Context, now time.Time) (int64, error)
+	Update(ctx context.Context, sheet Sheet) error
+	SoftDelete(ctx context.Context, id string, deletedBy primitive.ObjectID, deletedAt time.Time) error
+	Restore(ctx context.Context, id string, restoredAt time.Time) error
+	GetDeleted(ctx context.Context, userID string, pagination PaginationQuery) ([]Sheet, int64, error)
+	GetDeletedByID(ctx context.Context, id string) (Sheet, error)
+	GetDeletedBefore(ctx context.Context, cutoff time.Time) ([]Sheet, error)
 }
 
 type SheetUseCase interface {
 	Create(c context.Context, sheet Sheet) error
 	GetAll(c context.Context, pagination PaginationQuery) ([]SheetListItem, int64, error)
-	Delete(c context.Context, id string) error
+	Delete(c context.Context, id string) (SheetDeleteResult, error)
 	GetByUserID(c context.Context, userID string, pagination PaginationQuery) ([]SheetListItem, int64, error)
 	GetByID(c context.Context, id string) (Sheet, error)
 	UpdateStatus(c context.Context, id string, status SheetStatus, approvedBy primitive.ObjectID, approvedAt time.Time) error
+	Close(c context.Context, id string, closedBy primitive.ObjectID, reason SheetCloseReason, closedAt time.Time) error
+	CloseExpired(c context.Context, now time.Time) (int64, error)
+	Update(c context.Context, id string, req SheetUpdateRequest, now time.Time) (Sheet, error)
+	Trash(c context.Context, id string, deletedBy primitive.ObjectID, deletedAt time.Time) error
+	Restore(c context.Context, id string, restoredAt time.Time) error
+	GetTrash(c context.Context, userID string, pagination PaginationQuery) ([]SheetListItem, int64, error)
+	GetTrashedByID(c context.Context, id string) (Sheet, error)
+	PurgeTrash(c context.Context, cutoff time.Time) (TrashPurgeResult, error)
+	Duplicate(c context.Context, sourceID string, sheet Sheet) ([]Poll, error)
+	CreateWithPolls(c context.Context, sheet Sheet, polls []Poll) error
 }
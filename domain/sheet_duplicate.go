@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SheetDuplicateRequest optionally renames the copy; blank fields keep the source values.
+type SheetDuplicateRequest struct {
+	Title string `json:"title,omitempty" form:"title"`
+	Venue string `json:"venue,omitempty" form:"venue"`
+}
+
+// Duplicate returns a new, unsaved sheet owned by ownerID with the settings of s. The
+// copy has no status, approval or schedule: opening and closing times usually belong to
+// the previous run and are set again on the copy.
+func (s Sheet) Duplicate(req SheetDuplicateRequest, ownerID primitive.ObjectID, now time.Time) Sheet {
+	sheet := Sheet{
+		ID:              primitive.NewObjectID(),
+		UserID:          ownerID,
+		Title:           s.Title,
+		Venue:           s.Venue,
+		Description:     s.Description,
+		IsPhoneRequired: s.IsPhoneRequired,
+		IsQuiz:          s.IsQuiz,
+		ShufflePolls:    s.ShufflePolls,
+		DedupMode:       s.DedupMode,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if title := strings.TrimSpace(req.Title); title != "" {
+		sheet.Title = title
+	}
+	if venue := strings.TrimSpace(req.Venue); venue != "" {
+		sheet.Venue = venue
+	}
+	return sheet
+}
+
+// DuplicatePolls copies polls into sheetID with fresh identifiers and empty results.
+// Display rules are pointed at the copies. Option images are not copied because stored
+// media is owned by a single poll and removed with it.
+func DuplicatePolls(polls []Poll, sheetID primitive.ObjectID, now time.Time) []Poll {
+	ids := make(map[primitive.ObjectID]primitive.ObjectID, len(polls))
+	for _, poll := range polls {
+		ids[poll.ID] = primitive.NewObjectID()
+	}
+
+	copies := make([]Poll, 0, len(polls))
+	for _, poll := range polls {
+		duplicate := Poll{
+			ID:             ids[poll.ID],
+			SheetID:        sheetID,
+			Position:       poll.Position,
+			Title:          poll.Title,
+			Category:       append([]string(nil), poll.Category...),
+			Options:        append([]string(nil), poll.Options...),
+			Rows:           append([]string(nil), poll.Rows...),
+			PollType:       poll.PollType,
+			Required:       poll.Required,
+			ShuffleOptions: poll.ShuffleOptions,
+			AllowOther:     poll.AllowOther,
+			MinSelections:  poll.MinSelections,
+			MaxSelections:  poll.MaxSelections,
+			Scale:          poll.Scale,
+			Slide:          poll.Slide,
+			CorrectOptions: append([]int(nil), poll.CorrectOptions...),
+			Points:         poll.Points,
+			Description:    poll.Description,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		if poll.ShowIf != nil {
+			if target, ok := ids[poll.ShowIf.PollID]; ok {
+				duplicate.ShowIf = &DisplayRule{PollID: target, Options: append([]int(nil), poll.ShowIf.Options...)}
+			}
+		}
+		duplicate.ResetAggregates()
+		copies = append(copies, duplicate)
+	}
+	return copies
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxImportSize caps uploaded sheet import files.
+const MaxImportSize = 2 << 20
+
+// ImportListSeparator separates multiple options or categories within one import cell.
+const ImportListSeparator = "|"
+
+// Import column names, matched case-insensitively against the file's header row.
+const (
+	ImportColumnTitle       = "title"
+	ImportColumnType        = "type"
+	ImportColumnOptions     = "options"
+	ImportColumnCategories  = "categories"
+	ImportColumnDescription = "description"
+)
+
+var (
+	ErrInvalidImportFile = errors.New("invalid import file")
+	ErrImportTooLarge    = fmt.Errorf("import file exceeds the %d MB limit", MaxImportSize>>20)
+	ErrImportRows        = errors.New("import contains invalid rows")
+)
+
+// ImportRowError reports why one row of an import file was rejected. Row is the
+// 1-based line in the file, counting the header.
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,194 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSheetAcceptsResponses(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	later := now.Add(time.Hour)
+
+	t.Run("status", func(t *testing.T) {
+		assert.NoError(t, domain.Sheet{Status: domain.SheetStatusPublished}.AcceptsResponses(now))
+		assert.ErrorIs(t, domain.Sheet{Status: domain.SheetStatusPending}.AcceptsResponses(now), domain.ErrSheetNotApproved)
+		assert.ErrorIs(t, domain.Sheet{Status: domain.SheetStatusRejected}.AcceptsResponses(now), domain.ErrSheetRejected)
+		assert.ErrorIs(t, domain.Sheet{Status: domain.SheetStatusFinished}.AcceptsResponses(now), domain.ErrSheetClosed)
+	})
+
+	t.Run("window", func(t *testing.T) {
+		assert.NoError(t, domain.Sheet{Status: domain.SheetStatusPublished, OpensAt: &earlier, ClosesAt: &later}.AcceptsResponses(now))
+		assert.ErrorIs(t, domain.Sheet{Status: domain.SheetStatusPublished, OpensAt: &later}.AcceptsResponses(now), domain.ErrSheetNotOpenYet)
+		assert.ErrorIs(t, domain.Sheet{Status: domain.SheetStatusPublished, ClosesAt: &earlier}.AcceptsResponses(now), domain.ErrSheetClosed)
+		assert.ErrorIs(t, domain.Sheet{Status: domain.SheetStatusPublished, ClosesAt: &now}.AcceptsResponses(now), domain.ErrSheetClosed)
+	})
+
+}
+
+func TestSheetApplyUpdate(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	later := now.Add(time.Hour)
+	title := " Renamed "
+	blank := " "
+	required := true
+
+	t.Run("metadata", func(t *testing.T) {
+		sheet := domain.Sheet{Title: "Old", Status: domain.SheetStatusFinished}
+		assert.NoError(t, sheet.ApplyUpdate(domain.SheetUpdateRequest{Title: &title}, true, now))
+		assert.Equal(t, "Renamed", sheet.Title)
+		assert.Equal(t, now, sheet.UpdatedAt)
+
+		assert.ErrorIs(t, sheet.ApplyUpdate(domain.SheetUpdateRequest{Venue: &blank}, false, now), domain.ErrInvalidSheetUpdate)
+		assert.ErrorIs(t, sheet.ApplyUpdate(domain.SheetUpdateRequest{ClosesAt: &later}, false, now), domain.ErrSheetFieldLocked)
+	})
+
+	t.Run("responses", func(t *testing.T) {
+		sheet := domain.Sheet{Status: domain.SheetStatusPublished}
+		assert.ErrorIs(t, sheet.ApplyUpdate(domain.SheetUpdateRequest{IsPhoneRequired: &required}, true, now), domain.ErrSheetFieldLocked)
+		assert.NoError(t, sheet.ApplyUpdate(domain.SheetUpdateRequest{IsPhoneRequired: &required, ClosesAt: &later}, false, now))
+		assert.True(t, sheet.IsPhoneRequired)
+		assert.Equal(t, later, *sheet.ClosesAt)
+	})
+
+	t.Run("window", func(t *testing.T) {
+		open := domain.Sheet{Status: domain.SheetStatusPublished, OpensAt: &earlier}
+		assert.ErrorIs(t, open.ApplyUpdate(domain.SheetUpdateRequest{OpensAt: &later}, false, now), domain.ErrSheetFieldLocked)
+
+		pending := domain.Sheet{Status: domain.SheetStatusPending}
+		assert.ErrorIs(t, pending.ApplyUpdate(domain.SheetUpdateRequest{ClosesAt: &earlier}, false, now), domain.ErrInvalidSheetUpdate)
+
+		closesAt := later.Add(time.Hour)
+		assert.ErrorIs(t, pending.ApplyUpdate(domain.SheetUpdateRequest{OpensAt: &closesAt, ClosesAt: &later}, false, now), domain.ErrInvalidSheetUpdate)
+	})
+}
+
+func TestSheetDuplicate(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	closesAt := now.Add(-time.Hour)
+	owner := primitive.NewObjectID()
+
+	source := domain.Sheet{
+		ID:              primitive.NewObjectID(),
+		UserID:          primitive.NewObjectID(),
+		Title:           "Weekly check-in",
+		Venue:           "Hall A",
+		Description:     "Every Monday",
+		Status:          domain.SheetStatusFinished,
+		IsPhoneRequired: true,
+		DedupMode:       domain.DedupToken,
+		ClosesAt:        &closesAt,
+		CloseReason:     domain.SheetClosedBySchedule,
+	}
+
+	t.Run("sheet", func(t *testing.T) {
+		copied := source.Duplicate(domain.SheetDuplicateRequest{Venue: " Hall B "}, owner, now)
+
+		assert.NotEqual(t, source.ID, copied.ID)
+		assert.Equal(t, owner, copied.UserID)
+		assert.Equal(t, "Weekly check-in", copied.Title)
+		assert.Equal(t, "Hall B", copied.Venue)
+		assert.Equal(t, "Every Monday", copied.Description)
+		assert.True(t, copied.IsPhoneRequired)
+		assert.Equal(t, domain.DedupToken, copied.DedupMode)
+		assert.Empty(t, copied.Status)
+		assert.Nil(t, copied.ClosesAt)
+		assert.Empty(t, copied.CloseReason)
+		assert.Equal(t, now, copied.CreatedAt)
+	})
+
+	t.Run("polls", func(t *testing.T) {
+		trigger := domain.Poll{
+			ID:          primitive.NewObjectID(),
+			SheetID:     source.ID,
+			Position:    1,
+			Title:       "Attending?",
+			PollType:    domain.PollTypeSingleChoice,
+			Options:     []string{"Yes", "No"},
+			Category:    []string{"general"},
+			Participant: 12,
+			Votes:       []int{8, 4},
+			OptionMedia: []domain.OptionMedia{{Key: "key.png"}},
+		}
+		followUp := domain.Poll{
+			ID:          primitive.NewObjectID(),
+			SheetID:     source.ID,
+			Position:    2,
+			Title:       "Why not?",
+			PollType:    domain.PollTypeOpinion,
+			Category:    []string{"general"},
+			Participant: 4,
+			Responses:   []string{"busy"},
+			ShowIf:      &domain.DisplayRule{PollID: trigger.ID, Options: []int{1}},
+		}
+		sheetID := primitive.NewObjectID()
+
+		copies := domain.DuplicatePolls([]domain.Poll{trigger, followUp}, sheetID, now)
+
+		assert.Len(t, copies, 2)
+		assert.NotEqual(t, trigger.ID, copies[0].ID)
+		assert.Equal(t, sheetID, copies[0].SheetID)
+		assert.Equal(t, []string{"Yes", "No"}, copies[0].Options)
+		assert.Equal(t, []int{0, 0}, copies[0].Votes)
+		assert.Zero(t, copies[0].Participant)
+		assert.Nil(t, copies[0].OptionMedia)
+
+		assert.Zero(t, copies[1].Participant)
+		assert.Empty(t, copies[1].Responses)
+		assert.Equal(t, copies[0].ID, copies[1].ShowIf.PollID)
+		assert.Equal(t, []int{1}, copies[1].ShowIf.Options)
+		assert.Equal(t, []int{8, 4}, trigger.Votes)
+	})
+}
+
+func TestTemplate(t *testing.T) {
+	opensAt := time.Date(2024, 5, 6, 9, 0, 0, 0, time.UTC)
+	payload := domain.SheetCreateRequest{
+		Title: "Weekly check-in",
+		Venue: "Hall A",
+		Polls: []domain.SheetCreatePoll{{Title: "Attending?", PollType: "single_choice", Options: []string{"Yes", "No"}, Category: []string{"general"}}},
+	}
+
+	t.Run("apply", func(t *testing.T) {
+		var template domain.Template
+		err := template.Apply(domain.TemplateRequest{
+			Name:       " Check-in ",
+			Categories: []string{"Events", " events", ""},
+			Tags:       []string{"Weekly", "short"},
+			Sheet:      payload,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Check-in", template.Name)
+		assert.Equal(t, []string{"events"}, template.Categories)
+		assert.Equal(t, []string{"weekly", "short"}, template.Tags)
+		assert.Equal(t, payload, template.Sheet)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var template domain.Template
+		assert.ErrorIs(t, template.Apply(domain.TemplateRequest{Name: " ", Sheet: payload}), domain.ErrInvalidTemplate)
+
+		scheduled := payload
+		scheduled.OpensAt = &opensAt
+		assert.ErrorIs(t, template.Apply(domain.TemplateRequest{Name: "Check-in", Sheet: scheduled}), domain.ErrInvalidTemplate)
+	})
+
+	t.Run("sheet request", func(t *testing.T) {
+		template := domain.Template{Sheet: payload}
+
+		req := template.SheetRequest(domain.TemplateInstantiateRequest{Venue: " Hall B ", OpensAt: &opensAt})
+
+		assert.Equal(t, "Weekly check-in", req.Title)
+		assert.Equal(t, "Hall B", req.Venue)
+		assert.Equal(t, &opensAt, req.OpensAt)
+		assert.Nil(t, req.ClosesAt)
+		assert.Equal(t, payload.Polls, req.Polls)
+		assert.Equal(t, "Hall A", template.Sheet.Venue)
+	})
+}
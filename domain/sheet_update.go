@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidSheetUpdate = errors.New("invalid sheet update")
+	ErrSheetFieldLocked   = errors.New("field can no longer be changed")
+)
+
+// SheetUpdateRequest is a partial sheet update; nil fields are left unchanged.
+type SheetUpdateRequest struct {
+	Title           *string    `json:"title,omitempty"`
+	Venue           *string    `json:"venue,omitempty"`
+	Description     *string    `json:"description,omitempty"`
+	IsPhoneRequired *bool      `json:"is_phone_required,omitempty"`
+	DedupMode       *string    `json:"dedup_mode,omitempty"`
+	ShufflePolls    *bool      `json:"shuffle_polls,omitempty"`
+	OpensAt         *time.Time `json:"opens_at,omitempty"`
+	ClosesAt        *time.Time `json:"closes_at,omitempty"`
+}
+
+// ApplyUpdate applies a partial update to the sheet. Title, venue and description can
+// always change. Finished and rejected sheets accept nothing else; once respondents
+// have answered, the phone requirement, dedup mode and opening time are fixed, and a
+// published sheet that already opened keeps its opening time.
+func (s *Sheet) ApplyUpdate(req SheetUpdateRequest, hasResponses bool, now time.Time) error {
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		if title == "" {
+			return fmt.Errorf("%w: title cannot be empty", ErrInvalidSheetUpdate)
+		}
+		s.Title = title
+	}
+	if req.Venue != nil {
+		venue := strings.TrimSpace(*req.Venue)
+		if venue == "" {
+			return fmt.Errorf("%w: venue cannot be empty", ErrInvalidSheetUpdate)
+		}
+		s.Venue = venue
+	}
+	if req.Description != nil {
+		s.Description = strings.TrimSpace(*req.Description)
+	}
+
+	settings := map[string]bool{
+		"is_phone_required": req.IsPhoneRequired != nil,
+		"dedup_mode":        req.DedupMode != nil,
+		"shuffle_polls":     req.ShufflePolls != nil,
+		"opens_at":          req.OpensAt != nil,
+		"closes_at":         req.ClosesAt != nil,
+	}
+	if s.Status == SheetStatusFinished || s.Status == SheetStatusRejected {
+		for _, field := range []string{"is_phone_required", "dedup_mode", "shuffle_polls", "opens_at", "closes_at"} {
+			if settings[field] {
+				return fmt.Errorf("%w: %s cannot change on a %s sheet", ErrSheetFieldLocked, field, s.Status)
+			}
+		}
+		s.UpdatedAt = now
+		return nil
+	}
+
+	if hasResponses {
+		for _, field := range []string{"is_phone_required", "dedup_mode", "opens_at"} {
+			if settings[field] {
+				return fmt.Errorf("%w: %s cannot change once the sheet has responses", ErrSheetFieldLocked, field)
+			}
+		}
+	}
+
+	if req.IsPhoneRequired != nil {
+		s.IsPhoneRequired = *req.IsPhoneRequired
+	}
+	if req.DedupMode != nil {
+		mode, err := ParseDedupMode(strings.ToLower(strings.TrimSpace(*req.DedupMode)))
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidSheetUpdate, err.Error())
+		}
+		s.DedupMode = mode
+	}
+	if req.ShufflePolls != nil {
+		s.ShufflePolls = *req.ShufflePolls
+	}
+	if req.OpensAt != nil {
+		opened := s.OpensAt == nil || !s.OpensAt.After(now)
+		if s.Status == SheetStatusPublished && opened {
+			return fmt.Errorf("%w: opens_at cannot change once the sheet is open", ErrSheetFieldLocked)
+		}
+		opensAt := *req.OpensAt
+		s.OpensAt = &opensAt
+	}
+	if req.ClosesAt != nil {
+		closesAt := *req.ClosesAt
+		if !closesAt.After(now) {
+			return fmt.Errorf("%w: closes_at must be in the future", ErrInvalidSheetUpdate)
+		}
+		s.ClosesAt = &closesAt
+	}
+	if s.OpensAt != nil && s.ClosesAt != nil && !s.ClosesAt.After(*s.OpensAt) {
+		return fmt.Errorf("%w: closes_at must be after opens_at", ErrInvalidSheetUpdate)
+	}
+
+	s.UpdatedAt = now
+	return nil
+}
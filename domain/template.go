@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const CollectionTemplate = "templates"
+
+var (
+	ErrInvalidTemplate  = errors.New("invalid template")
+	ErrTemplateNotFound = errors.New("template not found")
+	ErrTemplateConflict = errors.New("template was changed concurrently")
+)
+
+// Template is a published sheet blueprint: a named create payload that admins can
+// turn into new sheets. Every update bumps Version.
+type Template struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name        string             `bson:"name" json:"name"`
+	Description string             `bson:"description,omitempty" json:"description,omitempty"`
+	Categories  []string           `bson:"categories" json:"categories"`
+	Tags        []string           `bson:"tags" json:"tags"`
+	Version     int                `bson:"version" json:"version"`
+	Sheet       SheetCreateRequest `bson:"sheet" json:"sheet"`
+	CreatedBy   primitive.ObjectID `bson:"createdBy" json:"-"`
+	CreatedAt   time.Time          `bson:"createdAt" json:"created_at"`
+	UpdatedAt   time.Time          `bson:"updatedAt" json:"updated_at"`
+}
+
+// TemplateRequest publishes or replaces a template.
+type TemplateRequest struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Categories  []string           `json:"categories,omitempty"`
+	Tags        []string           `json:"tags,omitempty"`
+	Sheet       SheetCreateRequest `json:"sheet"`
+}
+
+// TemplateInstantiateRequest customises the sheet created from a template; blank
+// fields keep the template values.
+type TemplateInstantiateRequest struct {
+	Title    string     `json:"title,omitempty"`
+	Venue    string     `json:"venue,omitempty"`
+	OpensAt  *time.Time `json:"opens_at,omitempty"`
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
+}
+
+// TemplateFilter narrows a template listing; empty fields match everything.
+type TemplateFilter struct {
+	Category string
+	Tag      string
+}
+
+type TemplateListResponse struct {
+	Data       []Template       `json:"data"`
+	Pagination PaginationResult `json:"pagination"`
+}
+
+type TemplateRepository interface {
+	Create(ctx context.Context, template *Template) error
+	GetByID(ctx context.Context, id string) (Template, error)
+	List(ctx context.Context, filter TemplateFilter, pagination PaginationQuery) ([]Template, int64, error)
+	Update(ctx context.Context, template *Template, previousVersion int) error
+	Delete(ctx context.Context, id string) error
+}
+
+type TemplateUsecase interface {
+	Publish(c context.Context, req TemplateRequest, createdBy primitive.ObjectID, now time.Time) (Template, error)
+	Update(c context.Context, id string, req TemplateRequest, now time.Time) (Template, error)
+	GetByID(c context.Context, id string) (Template, error)
+	List(c context.Context, filter TemplateFilter, pagination PaginationQuery) ([]Template, int64, error)
+	Delete(c context.Context, id string) error
+}
+
+// Apply replaces the template's content with req. Templates carry no schedule, since a
+// fixed opening or closing time would not fit later instances.
+func (t *Template) Apply(req TemplateRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidTemplate)
+	}
+	if req.Sheet.OpensAt != nil || req.Sheet.ClosesAt != nil {
+		return fmt.Errorf("%w: templates cannot set opens_at or closes_at", ErrInvalidTemplate)
+	}
+
+	t.Name = name
+	t.Description = strings.TrimSpace(req.Description)
+	t.Categories = normalizeTerms(req.Categories)
+	t.Tags = normalizeTerms(req.Tags)
+	t.Sheet = req.Sheet
+	return nil
+}
+
+// SheetRequest returns the create payload for a new sheet based on the template.
+func (t Template) SheetRequest(req TemplateInstantiateRequest) SheetCreateRequest {
+	payload := t.Sheet
+	if title := strings.TrimSpace(req.Title); title != "" {
+		payload.Title = title
+	}
+	if venue := strings.TrimSpace(req.Venue); venue != "" {
+		payload.Venue = venue
+	}
+	payload.OpensAt = req.OpensAt
+	payload.ClosesAt = req.ClosesAt
+	return payload
+}
+
+// normalizeTerms trims, lowercases and de-duplicates browsing terms, keeping their order.
+func normalizeTerms(values []string) []string {
+	terms := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		term := strings.ToLower(strings.TrimSpace(value))
+		if term == "" {
+			continue
+		}
+		if _, ok := seen[term]; ok {
+			continue
+		}
+		seen[term] = struct{}{}
+		terms = append(terms, term)
+	}
+	return terms
+}
@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateApply(t *testing.T) {
+	opensAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		req     domain.TemplateRequest
+		wantErr error
+	}{
+		{"valid", domain.TemplateRequest{Name: "Daily standup", Sheet: domain.SheetCreateRequest{Title: "Standup"}}, nil},
+		{"blank name", domain.TemplateRequest{Name: "  "}, domain.ErrInvalidTemplate},
+		{"schedule", domain.TemplateRequest{Name: "Daily standup", Sheet: domain.SheetCreateRequest{OpensAt: &opensAt}}, domain.ErrInvalidTemplate},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			template := domain.Template{Name: "Original"}
+			err := template.Apply(tc.req)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, "Original", template.Name)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.req.Name, template.Name)
+			assert.Equal(t, tc.req.Sheet, template.Sheet)
+		})
+	}
+
+	t.Run("normalizes terms", func(t *testing.T) {
+		var template domain.Template
+		err := template.Apply(domain.TemplateRequest{
+			Name:        " Retro ",
+			Description: " Sprint retro ",
+			Categories:  []string{" Team ", "team", ""},
+			Tags:        []string{"Agile", " agile", "Weekly"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Retro", template.Name)
+		assert.Equal(t, "Sprint retro", template.Description)
+		assert.Equal(t, []string{"team"}, template.Categories)
+		assert.Equal(t, []string{"agile", "weekly"}, template.Tags)
+	})
+}
+
+func TestTemplateSheetRequest(t *testing.T) {
+	opensAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	closesAt := opensAt.Add(time.Hour)
+	template := domain.Template{Sheet: domain.SheetCreateRequest{Title: "Standup", Venue: "Room 1", IsQuiz: true}}
+
+	t.Run("keeps template values", func(t *testing.T) {
+		payload := template.SheetRequest(domain.TemplateInstantiateRequest{Title: " ", Venue: ""})
+
+		assert.Equal(t, template.Sheet, payload)
+	})
+
+	t.Run("overrides title, venue and schedule", func(t *testing.T) {
+		payload := template.SheetRequest(domain.TemplateInstantiateRequest{Title: " Monday standup ", Venue: "Room 2", OpensAt: &opensAt, ClosesAt: &closesAt})
+
+		assert.Equal(t, "Monday standup", payload.Title)
+		assert.Equal(t, "Room 2", payload.Venue)
+		assert.True(t, payload.IsQuiz)
+		assert.Equal(t, &opensAt, payload.OpensAt)
+		assert.Equal(t, &closesAt, payload.ClosesAt)
+		assert.Equal(t, "Standup", template.Sheet.Title)
+	})
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// DefaultTrashRetention is how long trashed sheets and polls are kept before they are purged.
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+// ErrSheetInTrash refuses to restore a poll whose sheet is still in the trash.
+var ErrSheetInTrash = errors.New("the poll's sheet is in the trash; restore the sheet first")
+
+// TrashPurgeResult counts the records permanently removed by a trash purge.
+type TrashPurgeResult struct {
+	Sheets  int64 `json:"sheets"`
+	Polls   int64 `json:"polls"`
+	Ballots int64 `json:"ballots"`
+}
+
+// TrashRetention converts a retention period in days, falling back to the default when unset.
+func TrashRetention(days int) time.Duration {
+	if days <= 0 {
+		return DefaultTrashRetention
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
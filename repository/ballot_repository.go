@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ballotRepository struct {
+	database   mongo.Database
+	collection string
+}
+
+func NewBallotRepository(db mongo.Database, collection string) domain.BallotRepository {
+	return &ballotRepository{
+		database:   db,
+		collection: collection,
+	}
+}
+
+func (br *ballotRepository) Create(ctx context.Context, ballot *domain.Ballot) error {
+	collection := br.database.Collection(br.collection)
+	_, err := collection.InsertOne(ctx, ballot)
+	if mongodriver.IsDuplicateKeyError(err) {
+		return domain.ErrAlreadySubmitted
+	}
+	return err
+}
+
+func (br *ballotRepository) GetByID(ctx context.Context, id string) (domain.Ballot, error) {
+	collection := br.database.Collection(br.collection)
+
+	var ballot domain.Ballot
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ballot, err
+	}
+
+	err = collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&ballot)
+	return ballot, err
+}
+
+func (br *ballotRepository) GetByPollID(ctx context.Context, pollID string, pagination domain.PaginationQuery) ([]domain.Ballot, int64, error) {
+	collection := br.database.Collection(br.collection)
+
+	objectID, err := primitive.ObjectIDFromHex(pollID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filter := bson.M{"pollID": objectID}
+	findOptions := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+	if skip := pagination.Skip(); skip > 0 {
+		findOptions.SetSkip(skip)
+	}
+	if limit := pagination.Limit(); limit > 0 {
+		findOptions.SetLimit(limit)
+	}
+
+	cursor, err := collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var ballots []domain.Ballot
+	if err = cursor.All(ctx, &ballots); err != nil {
+		return nil, 0, err
+	}
+	if ballots == nil {
+		ballots = []domain.Ballot{}
+	}
+
+	total, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ballots, total, nil
+}
+
+// GetByPollIDs returns the ballots of the given polls. Callers pass the live polls of a
+// sheet so that ballots of trashed polls are left out.
+func (br *ballotRepository) GetByPollIDs(ctx context.Context, pollIDs []string) ([]domain.Ballot, error) {
+	objectIDs, err := objectIDsFromHex(pollIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(objectIDs) == 0 {
+		return []domain.Ballot{}, nil
+	}
+
+	return br.find(ctx, bson.M{"pollID": bson.M{"$in": objectIDs}})
+}
+
+func (br *ballotRepository) GetByRespondent(ctx context.Context, sheetID string, respondentKey string) ([]domain.Ballot, error) {
+	objectID, err := primitive.ObjectIDFromHex(sheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return br.find(ctx, bson.M{"sheetID": objectID, "respondentKey": respondentKey})
+}
+
+func (br *ballotRepository) find(ctx context.Context, filter bson.M) ([]domain.Ballot, error) {
+	collection := br.database.Collection(br.collection)
+
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var ballots []domain.Ballot
+	if err = cursor.All(ctx, &ballots); err != nil {
+		return nil, err
+	}
+	if ballots == nil {
+		ballots = []domain.Ballot{}
+	}
+
+	return ballots, nil
+}
+
+func (br *ballotRepository) Delete(ctx context.Context, id string) error {
+	collection := br.database.Collection(br.collection)
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return domain.ErrBallotNotFound
+	}
+
+	return nil
+}
+
+// EnsureIndexes creates the unique index that keeps deduplicated sheets at one ballot per
+// respondent and poll. Ballots of sheets without deduplication are left out of the index.
+func (br *ballotRepository) EnsureIndexes(ctx context.Context) error {
+	collection := br.database.Collection(br.collection)
+
+	_, err := collection.CreateIndex(ctx, mongodriver.IndexModel{
+		Keys: bson.D{{Key: "pollID", Value: 1}, {Key: "respondentKey", Value: 1}},
+		Options: options.Index().
+			SetName("pollID_respondentKey_unique").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"unique": true}),
+	})
+	return err
+}
+
+func (br *ballotRepository) CountByPollIDs(ctx context.Context, pollIDs []string) (int64, error) {
+	collection := br.database.Collection(br.collection)
+
+	objectIDs, err := objectIDsFromHex(pollIDs)
+	if err != nil {
+		return 0, err
+	}
+	if len(objectIDs) == 0 {
+		return 0, nil
+	}
+
+	return collection.CountDocuments(ctx, bson.M{"pollID": bson.M{"$in": objectIDs}})
+}
+
+func (br *ballotRepository) DeleteBySheetID(ctx context.Context, sheetID string) (int64, error) {
+	collection := br.database.Collection(br.collection)
+
+	objectID, err := primitive.ObjectIDFromHex(sheetID)
+	if err != nil {
+		return 0, err
+	}
+
+	return collection.DeleteMany(ctx, bson.M{"sheetID": objectID})
+}
+
+func (br *ballotRepository) DeleteByPollID(ctx context.Context, pollID string) (int64, error) {
+	collection := br.database.Collection(br.collection)
+
+	objectID, err := primitive.ObjectIDFromHex(pollID)
+	if err != nil {
+		return 0, err
+	}
+
+	return collection.DeleteMany(ctx, bson.M{"pollID": objectID})
+}
+
+// GetValuesByPollIDs returns the slide values of the given polls' ballots, keyed by poll ID
+// and in submission order.
+func (br *ballotRepository) GetValuesByPollIDs(ctx context.Context, pollIDs []string) (map[string][]float64, error) {
+	collection := br.database.Collection(br.collection)
+
+	objectIDs, err := objectIDsFromHex(pollIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string][]float64, len(pollIDs))
+	if len(objectIDs) == 0 {
+		return values, nil
+	}
+
+	opts := options.Find().
+		SetProjection(bson.M{"pollID": 1, "value": 1}).
+		SetSort(bson.D{{Key: "createdAt", Value: 1}})
+	cursor, err := collection.Find(ctx, bson.M{"pollID": bson.M{"$in": objectIDs}, "value": bson.M{"$exists": true}}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var ballots []domain.Ballot
+	if err = cursor.All(ctx, &ballots); err != nil {
+		return nil, err
+	}
+	for _, ballot := range ballots {
+		if ballot.Value != nil {
+			pollID := ballot.PollID.Hex()
+			values[pollID] = append(values[pollID], *ballot.Value)
+		}
+	}
+
+	return values, nil
+}
+
+func objectIDsFromHex(ids []string) ([]primitive.ObjectID, error) {
+	objectIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, err
+		}
+		objectIDs = append(objectIDs, objectID)
+	}
+	return objectIDs, nil
+}
@@ -0,0 +1,49 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo/mocks"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestCreateBallot(t *testing.T) {
+
+	collectionName := domain.CollectionBallot
+	ballot := &domain.Ballot{ID: primitive.NewObjectID(), PollID: primitive.NewObjectID(), RespondentKey: "token:respondent", Unique: true}
+
+	t.Run("success", func(t *testing.T) {
+		databaseHelper := &mocks.Database{}
+		collectionHelper := &mocks.Collection{}
+
+		collectionHelper.On("InsertOne", mock.Anything, ballot).Return(ballot.ID, nil).Once()
+		databaseHelper.On("Collection", collectionName).Return(collectionHelper)
+
+		br := repository.NewBallotRepository(databaseHelper, collectionName)
+
+		assert.NoError(t, br.Create(context.Background(), ballot))
+
+		collectionHelper.AssertExpectations(t)
+	})
+
+	t.Run("duplicate respondent", func(t *testing.T) {
+		databaseHelper := &mocks.Database{}
+		collectionHelper := &mocks.Collection{}
+
+		duplicate := mongodriver.WriteException{WriteErrors: mongodriver.WriteErrors{{Code: 11000, Message: "E11000 duplicate key error"}}}
+		collectionHelper.On("InsertOne", mock.Anything, ballot).Return(nil, duplicate).Once()
+		databaseHelper.On("Collection", collectionName).Return(collectionHelper)
+
+		br := repository.NewBallotRepository(databaseHelper, collectionName)
+
+		assert.ErrorIs(t, br.Create(context.Background(), ballot), domain.ErrAlreadySubmitted)
+
+		collectionHelper.AssertExpectations(t)
+	})
+}
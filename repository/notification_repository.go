@@ -95,6 +95,22 @@ func (nr *notificationRepository) UpdateStatus(ctx context.Context, id string, s
 	return err
 }
 
+// DeletePendingBySheetID removes unresolved sheet approval notifications for a sheet.
+func (nr *notificationRepository) DeletePendingBySheetID(ctx context.Context, sheetID string) (int64, error) {
+	collection := nr.database.Collection(nr.collection)
+
+	objectID, err := primitive.ObjectIDFromHex(sheetID)
+	if err != nil {
+		return 0, err
+	}
+
+	return collection.DeleteMany(ctx, bson.M{
+		"type":    domain.NotificationTypeSheetApproval,
+		"sheetID": objectID,
+		"status":  domain.NotificationPending,
+	})
+}
+
 func (nr *notificationRepository) Delete(ctx context.Context, id string) error {
 	collection := nr.database.Collection(nr.collection)
 
@@ -0,0 +1,53 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo/mocks"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestDeletePendingBySheetID(t *testing.T) {
+
+	databaseHelper := &mocks.Database{}
+	collectionHelper := &mocks.Collection{}
+
+	collectionName := domain.CollectionNotification
+	sheetID := primitive.NewObjectID()
+
+	t.Run("success", func(t *testing.T) {
+		filter := bson.M{
+			"type":    domain.NotificationTypeSheetApproval,
+			"sheetID": sheetID,
+			"status":  domain.NotificationPending,
+		}
+		collectionHelper.On("DeleteMany", mock.Anything, filter).Return(int64(2), nil).Once()
+
+		databaseHelper.On("Collection", collectionName).Return(collectionHelper)
+
+		nr := repository.NewNotificationRepository(databaseHelper, collectionName)
+
+		deleted, err := nr.DeletePendingBySheetID(context.Background(), sheetID.Hex())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), deleted)
+
+		collectionHelper.AssertExpectations(t)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		databaseHelper.On("Collection", collectionName).Return(collectionHelper)
+
+		nr := repository.NewNotificationRepository(databaseHelper, collectionName)
+
+		_, err := nr.DeletePendingBySheetID(context.Background(), "not-an-id")
+
+		assert.Error(t, err)
+	})
+}
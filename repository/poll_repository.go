@@ -37,24 +37,15 @@ func (pr *pollRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (pr *pollRepository) DeleteBySheetID(ctx context.Context, sheetID string) error {
+func (pr *pollRepository) DeleteBySheetID(ctx context.Context, sheetID string) (int64, error) {
 	collection := pr.database.Collection(pr.collection)
 
 	hexID, err := primitive.ObjectIDFromHex(sheetID)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	for {
-		deleted, err := collection.DeleteOne(ctx, bson.M{"sheetID": hexID})
-		if err != nil {
-			return err
-		}
-
-		if deleted == 0 {
-			return nil
-		}
-	}
+	return collection.DeleteMany(ctx, bson.M{"sheetID": hexID})
 }
 
 func (pr *pollRepository) Create(ctx context.Context, poll *domain.Poll) error {
@@ -72,10 +63,116 @@ func (pr *pollRepository) GetByID(ctx context.Context, id string) (domain.Poll,
 		return poll, err
 	}
 
-	err = collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&poll)
+	err = collection.FindOne(ctx, notDeleted(bson.M{"_id": objectID})).Decode(&poll)
+	return poll, err
+}
+
+func (pr *pollRepository) GetDeletedByID(ctx context.Context, id string) (domain.Poll, error) {
+	collection := pr.database.Collection(pr.collection)
+
+	var poll domain.Poll
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return poll, err
+	}
+
+	err = collection.FindOne(ctx, deleted(bson.M{"_id": objectID})).Decode(&poll)
 	return poll, err
 }
 
+func (pr *pollRepository) GetDeletedBySheetID(ctx context.Context, sheetID string) ([]domain.Poll, error) {
+	idHex, err := primitive.ObjectIDFromHex(sheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pr.findAll(ctx, deleted(bson.M{"sheetID": idHex}))
+}
+
+func (pr *pollRepository) GetDeletedBefore(ctx context.Context, cutoff time.Time) ([]domain.Poll, error) {
+	return pr.findAll(ctx, bson.M{"deletedAt": bson.M{"$lte": cutoff}})
+}
+
+func (pr *pollRepository) SoftDelete(ctx context.Context, id string, deletedBy primitive.ObjectID, deletedAt time.Time) error {
+	collection := pr.database.Collection(pr.collection)
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	result, err := collection.UpdateOne(ctx, notDeleted(bson.M{"_id": objectID}), softDeleteUpdate(deletedBy, deletedAt))
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrPollNotFound
+	}
+	return nil
+}
+
+func (pr *pollRepository) SoftDeleteBySheetID(ctx context.Context, sheetID string, deletedBy primitive.ObjectID, deletedAt time.Time) (int64, error) {
+	collection := pr.database.Collection(pr.collection)
+
+	idHex, err := primitive.ObjectIDFromHex(sheetID)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := collection.UpdateMany(ctx, notDeleted(bson.M{"sheetID": idHex}), softDeleteUpdate(deletedBy, deletedAt))
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+func (pr *pollRepository) Restore(ctx context.Context, id string, restoredAt time.Time) error {
+	collection := pr.database.Collection(pr.collection)
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = collection.UpdateOne(ctx, deleted(bson.M{"_id": objectID}), restoreUpdate(restoredAt))
+	return err
+}
+
+// RestoreBySheetID restores the sheet's polls that were trashed at deletedAt, i.e.
+// together with the sheet, leaving polls deleted on their own in the trash.
+func (pr *pollRepository) RestoreBySheetID(ctx context.Context, sheetID string, deletedAt time.Time, restoredAt time.Time) (int64, error) {
+	collection := pr.database.Collection(pr.collection)
+
+	idHex, err := primitive.ObjectIDFromHex(sheetID)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := collection.UpdateMany(ctx, bson.M{"sheetID": idHex, "deletedAt": deletedAt}, restoreUpdate(restoredAt))
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+func (pr *pollRepository) findAll(ctx context.Context, filter bson.M) ([]domain.Poll, error) {
+	collection := pr.database.Collection(pr.collection)
+
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "position", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var polls []domain.Poll
+	if err = cursor.All(ctx, &polls); err != nil {
+		return nil, err
+	}
+	if polls == nil {
+		polls = []domain.Poll{}
+	}
+	return polls, nil
+}
+
 func (pr *pollRepository) GetPollBySheetID(ctx context.Context, sheetID string, pagination domain.PaginationQuery) ([]domain.Poll, int64, error) {
 	collection := pr.database.Collection(pr.collection)
 
@@ -84,8 +181,8 @@ func (pr *pollRepository) GetPollBySheetID(ctx context.Context, sheetID string,
 		return nil, 0, err
 	}
 
-	filter := bson.M{"sheetID": idHex}
-	findOptions := options.Find()
+	filter := notDeleted(bson.M{"sheetID": idHex})
+	findOptions := options.Find().SetSort(bson.D{{Key: "position", Value: 1}, {Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}})
 	if skip := pagination.Skip(); skip > 0 {
 		findOptions.SetSkip(skip)
 	}
@@ -117,23 +214,46 @@ func (pr *pollRepository) GetPollBySheetID(ctx context.Context, sheetID string,
 func (pr *pollRepository) EditPoll(ctx context.Context, poll *domain.Poll) error {
 	collection := pr.database.Collection(pr.collection)
 
-	filter := bson.M{"_id": poll.ID}
+	// The participant filter fails the update when a ballot arrives after the aggregates
+	// below were taken, so the stored counters always match the stored ballots.
+	filter := bson.M{"_id": poll.ID, "participant": poll.Participant}
 
 	update := bson.M{
 		"$set": bson.M{
-			"title":       poll.Title,
-			"description": poll.Description,
-			"options":     poll.Options,
-			"pollType":    poll.PollType,
-			"category":    poll.Category,
-			"updatedAt":   time.Now(),
+			"title":          poll.Title,
+			"description":    poll.Description,
+			"options":        poll.Options,
+			"rows":           poll.Rows,
+			"pollType":       poll.PollType,
+			"scale":          poll.Scale,
+			"slide":          poll.Slide,
+			"correctOptions": poll.CorrectOptions,
+			"points":         poll.Points,
+			"allowOther":     poll.AllowOther,
+			"required":       poll.Required,
+			"shuffleOptions": poll.ShuffleOptions,
+			"minSelections":  poll.MinSelections,
+			"maxSelections":  poll.MaxSelections,
+			"category":       poll.Category,
+			"participant":    poll.Participant,
+			"votes":          poll.Votes,
+			"responses":      poll.Responses,
+			"ranking":        poll.Ranking,
+			"matrixVotes":    poll.MatrixVotes,
+			"correctCount":   poll.CorrectCount,
+			"otherResponses": poll.OtherResponses,
+			"optionMedia":    poll.OptionMedia,
+			"updatedAt":      time.Now(),
 		},
 	}
 
-	_, err := collection.UpdateOne(ctx, filter, update)
+	result, err := collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return fmt.Errorf("failed to update poll: %v", err)
 	}
+	if result.MatchedCount == 0 {
+		return domain.ErrPollEditConflict
+	}
 
 	return nil
 
@@ -169,7 +289,19 @@ func (pr *pollRepository) AppendOpinionResponse(ctx context.Context, id string,
 	return err
 }
 
-func (pr *pollRepository) SubmitVote(ctx context.Context, id string, votes []int) error {
+func (pr *pollRepository) AppendOtherResponse(ctx context.Context, id string, response string) error {
+	collection := pr.database.Collection(pr.collection)
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": objectID}, bson.M{"$push": bson.M{"otherResponses": response}})
+	return err
+}
+
+func (pr *pollRepository) SubmitVote(ctx context.Context, id string, options []int) error {
 	collection := pr.database.Collection(pr.collection)
 
 	idHex, err := primitive.ObjectIDFromHex(id)
@@ -180,11 +312,8 @@ func (pr *pollRepository) SubmitVote(ctx context.Context, id string, votes []int
 	filter := bson.M{"_id": idHex}
 
 	updateDoc := bson.M{}
-	for i, voteCount := range votes {
-		if voteCount == 0 {
-			continue
-		}
-		updateDoc[fmt.Sprintf("votes.%d", i)] = voteCount
+	for _, option := range options {
+		updateDoc[fmt.Sprintf("votes.%d", option)] = 1
 	}
 
 	if len(updateDoc) == 0 {
@@ -208,9 +337,188 @@ func (pr *pollRepository) SubmitVote(ctx context.Context, id string, votes []int
 	return nil
 }
 
+func (pr *pollRepository) SubmitRanking(ctx context.Context, id string, order []int) error {
+	collection := pr.database.Collection(pr.collection)
+
+	idHex, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	if len(order) == 0 {
+		return domain.ErrNoVotesSubmitted
+	}
+
+	updateDoc := bson.M{
+		fmt.Sprintf("votes.%d", order[0]): 1,
+		"participant":                     1,
+	}
+	for position, option := range order {
+		updateDoc[fmt.Sprintf("ranking.bordaScores.%d", option)] = domain.BordaPoints(position, len(order))
+		updateDoc[fmt.Sprintf("ranking.positionTotals.%d", option)] = position + 1
+	}
+
+	update := bson.M{
+		"$inc": updateDoc,
+		"$set": bson.M{
+			"updatedAt": time.Now(),
+		},
+	}
+
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": idHex}, update)
+	return err
+}
+
+func (pr *pollRepository) SubmitSlideValue(ctx context.Context, id string, bucket int) error {
+	collection := pr.database.Collection(pr.collection)
+
+	idHex, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$inc": bson.M{
+			fmt.Sprintf("votes.%d", bucket): 1,
+			"participant":                   1,
+		},
+		"$set": bson.M{
+			"updatedAt": time.Now(),
+		},
+	}
+
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": idHex}, update)
+	return err
+}
+
+func (pr *pollRepository) SubmitMatrix(ctx context.Context, id string, columns []int) error {
+	collection := pr.database.Collection(pr.collection)
+
+	idHex, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	if len(columns) == 0 {
+		return domain.ErrNoVotesSubmitted
+	}
+
+	updateDoc := bson.M{"participant": 1}
+	for row, column := range columns {
+		updateDoc[fmt.Sprintf("matrixVotes.%d.%d", row, column)] = 1
+	}
+
+	update := bson.M{
+		"$inc": updateDoc,
+		"$set": bson.M{
+			"updatedAt": time.Now(),
+		},
+	}
+
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": idHex}, update)
+	return err
+}
+
+func (pr *pollRepository) IncrementCorrect(ctx context.Context, id string) error {
+	collection := pr.database.Collection(pr.collection)
+
+	idHex, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": idHex}, bson.M{"$inc": bson.M{"correctCount": 1}})
+	return err
+}
+
+func (pr *pollRepository) UpdateAggregates(ctx context.Context, poll *domain.Poll) error {
+	collection := pr.database.Collection(pr.collection)
+
+	update := bson.M{
+		"$set": bson.M{
+			"participant":    poll.Participant,
+			"votes":          poll.Votes,
+			"responses":      poll.Responses,
+			"ranking":        poll.Ranking,
+			"matrixVotes":    poll.MatrixVotes,
+			"correctCount":   poll.CorrectCount,
+			"otherResponses": poll.OtherResponses,
+			"updatedAt":      time.Now(),
+		},
+	}
+
+	_, err := collection.UpdateOne(ctx, bson.M{"_id": poll.ID}, update)
+	return err
+}
+
 func NewPollRepository(database mongo.Database, collection string) domain.PollRepository {
 	return &pollRepository{
 		database:   database,
 		collection: collection,
 	}
 }
+
+func (pr *pollRepository) UpdatePositions(ctx context.Context, polls []domain.Poll) error {
+	collection := pr.database.Collection(pr.collection)
+
+	now := time.Now()
+	for _, poll := range polls {
+		update := bson.M{"$set": bson.M{"position": poll.Position, "updatedAt": now}}
+		if _, err := collection.UpdateOne(ctx, bson.M{"_id": poll.ID, "sheetID": poll.SheetID}, update); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetOptionMedia attaches media to its option. previousKey names the attachment being
+// replaced, or is empty when the option had none; if the option's attachment no longer
+// matches, the update is refused so that concurrent uploads cannot overwrite each other.
+func (pr *pollRepository) SetOptionMedia(ctx context.Context, id string, media domain.OptionMedia, previousKey string) error {
+	collection := pr.database.Collection(pr.collection)
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"_id": objectID, "optionMedia.option": bson.M{"$ne": media.Option}}
+	update := bson.M{"$push": bson.M{"optionMedia": media}, "$set": bson.M{"updatedAt": time.Now()}}
+	if previousKey != "" {
+		filter = bson.M{"_id": objectID, "optionMedia": bson.M{"$elemMatch": bson.M{"option": media.Option, "key": previousKey}}}
+		update = bson.M{"$set": bson.M{"optionMedia.$": media, "updatedAt": time.Now()}}
+	}
+
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrMediaConflict
+	}
+	return nil
+}
+
+// RemoveOptionMedia detaches the attachment stored under key from its option.
+func (pr *pollRepository) RemoveOptionMedia(ctx context.Context, id string, option int, key string) error {
+	collection := pr.database.Collection(pr.collection)
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$pull": bson.M{"optionMedia": bson.M{"option": option, "key": key}},
+		"$set":  bson.M{"updatedAt": time.Now()},
+	}
+	result, err := collection.UpdateOne(ctx, bson.M{"_id": objectID, "optionMedia.key": key}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrMediaNotFound
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo/mocks"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestSoftDeletePoll(t *testing.T) {
+
+	databaseHelper := &mocks.Database{}
+	collectionHelper := &mocks.Collection{}
+
+	collectionName := domain.CollectionPoll
+	pollID := primitive.NewObjectID()
+	deletedBy := primitive.NewObjectID()
+	deletedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	filter := bson.M{"_id": pollID, "deletedAt": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"deletedAt": deletedAt, "deletedBy": deletedBy, "updatedAt": deletedAt}}
+
+	t.Run("success", func(t *testing.T) {
+		collectionHelper.On("UpdateOne", mock.Anything, filter, update).Return(&mongodriver.UpdateResult{MatchedCount: 1, ModifiedCount: 1}, nil).Once()
+
+		databaseHelper.On("Collection", collectionName).Return(collectionHelper)
+
+		pr := repository.NewPollRepository(databaseHelper, collectionName)
+
+		err := pr.SoftDelete(context.Background(), pollID.Hex(), deletedBy, deletedAt)
+
+		assert.NoError(t, err)
+
+		collectionHelper.AssertExpectations(t)
+	})
+
+	t.Run("already deleted", func(t *testing.T) {
+		collectionHelper.On("UpdateOne", mock.Anything, filter, update).Return(&mongodriver.UpdateResult{}, nil).Once()
+
+		databaseHelper.On("Collection", collectionName).Return(collectionHelper)
+
+		pr := repository.NewPollRepository(databaseHelper, collectionName)
+
+		err := pr.SoftDelete(context.Background(), pollID.Hex(), deletedBy, deletedAt)
+
+		assert.ErrorIs(t, err, domain.ErrPollNotFound)
+
+		collectionHelper.AssertExpectations(t)
+	})
+}
+
+func TestRestorePollsBySheetID(t *testing.T) {
+
+	databaseHelper := &mocks.Database{}
+	collectionHelper := &mocks.Collection{}
+
+	collectionName := domain.CollectionPoll
+	sheetID := primitive.NewObjectID()
+	deletedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	restoredAt := deletedAt.Add(time.Hour)
+
+	t.Run("restores polls trashed with the sheet", func(t *testing.T) {
+		filter := bson.M{"sheetID": sheetID, "deletedAt": deletedAt}
+		update := bson.M{
+			"$unset": bson.M{"deletedAt": "", "deletedBy": ""},
+			"$set":   bson.M{"updatedAt": restoredAt},
+		}
+		collectionHelper.On("UpdateMany", mock.Anything, filter, update).Return(&mongodriver.UpdateResult{MatchedCount: 3, ModifiedCount: 3}, nil).Once()
+
+		databaseHelper.On("Collection", collectionName).Return(collectionHelper)
+
+		pr := repository.NewPollRepository(databaseHelper, collectionName)
+
+		restored, err := pr.RestoreBySheetID(context.Background(), sheetID.Hex(), deletedAt, restoredAt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), restored)
+
+		collectionHelper.AssertExpectations(t)
+	})
+}
@@ -24,37 +24,52 @@ func (sr *sheetRepository) GetByUserID(ctx context.Context, userID string, pagin
 		return nil, 0, err
 	}
 
-	filter := bson.M{"userID": UID}
-	findOptions := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}})
-	if skip := pagination.Skip(); skip > 0 {
-		findOptions.SetSkip(skip)
-	}
-	if limit := pagination.Limit(); limit > 0 {
-		findOptions.SetLimit(limit)
-	}
+	return sr.find(ctx, collection, notDeleted(bson.M{"userID": UID}), pagination)
+}
 
-	cursor, err := collection.Find(ctx, filter, findOptions)
+func (sr *sheetRepository) GetByID(ctx context.Context, id string) (domain.Sheet, error) {
+	collection := sr.database.Collection(sr.collection)
+
+	UID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, 0, err
+		return domain.Sheet{}, err
 	}
 
-	var result []domain.Sheet
-	if err = cursor.All(ctx, &result); err != nil {
-		return nil, 0, err
-	}
-	if result == nil {
-		result = []domain.Sheet{}
-	}
+	var result domain.Sheet
+	err = collection.FindOne(ctx, notDeleted(bson.M{"_id": UID})).Decode(&result)
+	return result, err
+}
 
-	total, err := collection.CountDocuments(ctx, filter)
-	if err != nil {
-		return nil, 0, err
+func (sr *sheetRepository) Create(ctx context.Context, sheet domain.Sheet) error {
+	collection := sr.database.Collection(sr.collection)
+
+	_, err := collection.InsertOne(ctx, sheet)
+	return err
+}
+
+func (sr *sheetRepository) GetAll(ctx context.Context, pagination domain.PaginationQuery) ([]domain.Sheet, int64, error) {
+	collection := sr.database.Collection(sr.collection)
+
+	return sr.find(ctx, collection, notDeleted(bson.M{}), pagination)
+}
+
+// GetDeleted lists trashed sheets, most recently deleted first. An empty userID lists every owner's sheets.
+func (sr *sheetRepository) GetDeleted(ctx context.Context, userID string, pagination domain.PaginationQuery) ([]domain.Sheet, int64, error) {
+	collection := sr.database.Collection(sr.collection)
+
+	filter := bson.M{}
+	if userID != "" {
+		UID, err := primitive.ObjectIDFromHex(userID)
+		if err != nil {
+			return nil, 0, err
+		}
+		filter["userID"] = UID
 	}
 
-	return result, total, nil
+	return sr.find(ctx, collection, deleted(filter), pagination, bson.E{Key: "deletedAt", Value: -1})
 }
 
-func (sr *sheetRepository) GetByID(ctx context.Context, id string) (domain.Sheet, error) {
+func (sr *sheetRepository) GetDeletedByID(ctx context.Context, id string) (domain.Sheet, error) {
 	collection := sr.database.Collection(sr.collection)
 
 	UID, err := primitive.ObjectIDFromHex(id)
@@ -63,21 +78,48 @@ func (sr *sheetRepository) GetByID(ctx context.Context, id string) (domain.Sheet
 	}
 
 	var result domain.Sheet
-	err = collection.FindOne(ctx, bson.M{"_id": UID}).Decode(&result)
+	err = collection.FindOne(ctx, deleted(bson.M{"_id": UID})).Decode(&result)
 	return result, err
 }
 
-func (sr *sheetRepository) Create(ctx context.Context, sheet domain.Sheet) error {
+func (sr *sheetRepository) GetDeletedBefore(ctx context.Context, cutoff time.Time) ([]domain.Sheet, error) {
 	collection := sr.database.Collection(sr.collection)
 
-	_, err := collection.InsertOne(ctx, sheet)
+	sheets, _, err := sr.find(ctx, collection, bson.M{"deletedAt": bson.M{"$lte": cutoff}}, domain.PaginationQuery{})
+	return sheets, err
+}
+
+func (sr *sheetRepository) SoftDelete(ctx context.Context, id string, deletedBy primitive.ObjectID, deletedAt time.Time) error {
+	collection := sr.database.Collection(sr.collection)
+
+	UID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = collection.UpdateOne(ctx, notDeleted(bson.M{"_id": UID}), softDeleteUpdate(deletedBy, deletedAt))
 	return err
 }
 
-func (sr *sheetRepository) GetAll(ctx context.Context, pagination domain.PaginationQuery) ([]domain.Sheet, int64, error) {
+func (sr *sheetRepository) Restore(ctx context.Context, id string, restoredAt time.Time) error {
 	collection := sr.database.Collection(sr.collection)
 
-	findOptions := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	UID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = collection.UpdateOne(ctx, deleted(bson.M{"_id": UID}), restoreUpdate(restoredAt))
+	return err
+}
+
+// find runs a paginated query sorted by the given keys, newest sheets first by default.
+func (sr *sheetRepository) find(ctx context.Context, collection mongo.Collection, filter bson.M, pagination domain.PaginationQuery, sort ...bson.E) ([]domain.Sheet, int64, error) {
+	if len(sort) == 0 {
+		sort = []bson.E{{Key: "createdAt", Value: -1}}
+	}
+
+	findOptions := options.Find().SetSort(bson.D(sort))
 	if skip := pagination.Skip(); skip > 0 {
 		findOptions.SetSkip(skip)
 	}
@@ -85,7 +127,7 @@ func (sr *sheetRepository) GetAll(ctx context.Context, pagination domain.Paginat
 		findOptions.SetLimit(limit)
 	}
 
-	cursor, err := collection.Find(ctx, bson.D{}, findOptions)
+	cursor, err := collection.Find(ctx, filter, findOptions)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -98,7 +140,7 @@ func (sr *sheetRepository) GetAll(ctx context.Context, pagination domain.Paginat
 		sheets = []domain.Sheet{}
 	}
 
-	total, err := collection.CountDocuments(ctx, bson.D{})
+	total, err := collection.CountDocuments(ctx, filter)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -138,9 +180,77 @@ func (sr *sheetRepository) UpdateStatus(ctx context.Context, id string, status d
 	return err
 }
 
+func (sr *sheetRepository) Close(ctx context.Context, id string, closedBy primitive.ObjectID, reason domain.SheetCloseReason, closedAt time.Time) error {
+	collection := sr.database.Collection(sr.collection)
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	set := bson.M{
+		"status":      domain.SheetStatusFinished,
+		"closeReason": reason,
+		"closedAt":    closedAt,
+		"updatedAt":   closedAt,
+	}
+	if !closedBy.IsZero() {
+		set["closedBy"] = closedBy
+	}
+
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": objectID}, bson.M{"$set": set})
+	return err
+}
+
+func (sr *sheetRepository) CloseExpired(ctx context.Context, now time.Time) (int64, error) {
+	collection := sr.database.Collection(sr.collection)
+
+	filter := notDeleted(bson.M{
+		"status":   domain.SheetStatusPublished,
+		"closesAt": bson.M{"$lte": now},
+	})
+
+	update := bson.M{
+		"$set": bson.M{
+			"status":      domain.SheetStatusFinished,
+			"closeReason": domain.SheetClosedBySchedule,
+			"closedAt":    now,
+			"updatedAt":   now,
+		},
+	}
+
+	result, err := collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ModifiedCount, nil
+}
+
 func NewSheetRepository(db mongo.Database, collection string) domain.SheetRepository {
 	return &sheetRepository{
 		database:   db,
 		collection: collection,
 	}
 }
+
+func (sr *sheetRepository) Update(ctx context.Context, sheet domain.Sheet) error {
+	collection := sr.database.Collection(sr.collection)
+
+	update := bson.M{
+		"$set": bson.M{
+			"title":           sheet.Title,
+			"venue":           sheet.Venue,
+			"description":     sheet.Description,
+			"isPhoneRequired": sheet.IsPhoneRequired,
+			"dedupMode":       sheet.DedupMode,
+			"shufflePolls":    sheet.ShufflePolls,
+			"opensAt":         sheet.OpensAt,
+			"closesAt":        sheet.ClosesAt,
+			"updatedAt":       sheet.UpdatedAt,
+		},
+	}
+
+	_, err := collection.UpdateOne(ctx, bson.M{"_id": sheet.ID}, update)
+	return err
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// notDeleted restricts a filter to documents that have not been moved to the trash.
+func notDeleted(filter bson.M) bson.M {
+	filter["deletedAt"] = bson.M{"$exists": false}
+	return filter
+}
+
+// deleted restricts a filter to documents in the trash.
+func deleted(filter bson.M) bson.M {
+	filter["deletedAt"] = bson.M{"$exists": true}
+	return filter
+}
+
+func softDeleteUpdate(deletedBy primitive.ObjectID, deletedAt time.Time) bson.M {
+	return bson.M{"$set": bson.M{"deletedAt": deletedAt, "deletedBy": deletedBy, "updatedAt": deletedAt}}
+}
+
+func restoreUpdate(restoredAt time.Time) bson.M {
+	return bson.M{
+		"$unset": bson.M{"deletedAt": "", "deletedBy": ""},
+		"$set":   bson.M{"updatedAt": restoredAt},
+	}
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type templateRepository struct {
+	database   mongo.Database
+	collection string
+}
+
+func NewTemplateRepository(db mongo.Database, collection string) domain.TemplateRepository {
+	return &templateRepository{
+		database:   db,
+		collection: collection,
+	}
+}
+
+func (tr *templateRepository) Create(ctx context.Context, template *domain.Template) error {
+	collection := tr.database.Collection(tr.collection)
+	_, err := collection.InsertOne(ctx, template)
+	return err
+}
+
+func (tr *templateRepository) GetByID(ctx context.Context, id string) (domain.Template, error) {
+	collection := tr.database.Collection(tr.collection)
+
+	var template domain.Template
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		// No template can have a malformed ID.
+		return template, domain.ErrTemplateNotFound
+	}
+
+	err = collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&template)
+	return template, err
+}
+
+// List returns templates sorted by name, optionally limited to a category and a tag.
+func (tr *templateRepository) List(ctx context.Context, filter domain.TemplateFilter, pagination domain.PaginationQuery) ([]domain.Template, int64, error) {
+	collection := tr.database.Collection(tr.collection)
+
+	query := bson.M{}
+	if filter.Category != "" {
+		query["categories"] = filter.Category
+	}
+	if filter.Tag != "" {
+		query["tags"] = filter.Tag
+	}
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}})
+	if skip := pagination.Skip(); skip > 0 {
+		findOptions.SetSkip(skip)
+	}
+	if limit := pagination.Limit(); limit > 0 {
+		findOptions.SetLimit(limit)
+	}
+
+	cursor, err := collection.Find(ctx, query, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var templates []domain.Template
+	if err = cursor.All(ctx, &templates); err != nil {
+		return nil, 0, err
+	}
+	if templates == nil {
+		templates = []domain.Template{}
+	}
+
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return templates, total, nil
+}
+
+// Update stores the template only if it is still at previousVersion, so concurrent
+// edits cannot silently overwrite each other.
+func (tr *templateRepository) Update(ctx context.Context, template *domain.Template, previousVersion int) error {
+	collection := tr.database.Collection(tr.collection)
+
+	update := bson.M{
+		"$set": bson.M{
+			"name":        template.Name,
+			"description": template.Description,
+			"categories":  template.Categories,
+			"tags":        template.Tags,
+			"sheet":       template.Sheet,
+			"version":     template.Version,
+			"updatedAt":   template.UpdatedAt,
+		},
+	}
+
+	result, err := collection.UpdateOne(ctx, bson.M{"_id": template.ID, "version": previousVersion}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrTemplateConflict
+	}
+	return nil
+}
+
+func (tr *templateRepository) Delete(ctx context.Context, id string) error {
+	collection := tr.database.Collection(tr.collection)
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrTemplateNotFound
+	}
+
+	count, err := collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.ErrTemplateNotFound
+	}
+	return nil
+}
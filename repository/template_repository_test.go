@@ -0,0 +1,63 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo/mocks"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestTemplateMalformedID(t *testing.T) {
+	databaseHelper := &mocks.Database{}
+	collectionHelper := &mocks.Collection{}
+	databaseHelper.On("Collection", domain.CollectionTemplate).Return(collectionHelper)
+
+	tr := repository.NewTemplateRepository(databaseHelper, domain.CollectionTemplate)
+
+	_, err := tr.GetByID(context.Background(), "not-an-id")
+	assert.ErrorIs(t, err, domain.ErrTemplateNotFound)
+	assert.ErrorIs(t, tr.Delete(context.Background(), "not-an-id"), domain.ErrTemplateNotFound)
+
+	collectionHelper.AssertNotCalled(t, "FindOne", mock.Anything, mock.Anything)
+	collectionHelper.AssertNotCalled(t, "DeleteOne", mock.Anything, mock.Anything)
+}
+
+func TestUpdateTemplateVersion(t *testing.T) {
+
+	collectionName := domain.CollectionTemplate
+	template := &domain.Template{ID: primitive.NewObjectID(), Name: "Standup", Version: 4}
+
+	t.Run("success", func(t *testing.T) {
+		databaseHelper := &mocks.Database{}
+		collectionHelper := &mocks.Collection{}
+
+		collectionHelper.On("UpdateOne", mock.Anything, mock.Anything, mock.Anything).Return(&mongodriver.UpdateResult{MatchedCount: 1, ModifiedCount: 1}, nil).Once()
+		databaseHelper.On("Collection", collectionName).Return(collectionHelper)
+
+		tr := repository.NewTemplateRepository(databaseHelper, collectionName)
+
+		assert.NoError(t, tr.Update(context.Background(), template, 3))
+
+		collectionHelper.AssertExpectations(t)
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		databaseHelper := &mocks.Database{}
+		collectionHelper := &mocks.Collection{}
+
+		collectionHelper.On("UpdateOne", mock.Anything, mock.Anything, mock.Anything).Return(&mongodriver.UpdateResult{}, nil).Once()
+		databaseHelper.On("Collection", collectionName).Return(collectionHelper)
+
+		tr := repository.NewTemplateRepository(databaseHelper, collectionName)
+
+		assert.ErrorIs(t, tr.Update(context.Background(), template, 3), domain.ErrTemplateConflict)
+
+		collectionHelper.AssertExpectations(t)
+	})
+}
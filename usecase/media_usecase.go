@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type mediaUsecase struct {
+	pollRepository  domain.PollRepository
+	sheetRepository domain.SheetRepository
+	store           domain.BlobStore
+	contextTimeout  time.Duration
+}
+
+// GetPollSheet returns the sheet of a live poll, so that callers can check who owns it.
+func (m mediaUsecase) GetPollSheet(c context.Context, pollID string) (domain.Sheet, error) {
+	ctx, cancel := context.WithTimeout(c, m.contextTimeout)
+	defer cancel()
+
+	poll, err := m.getPoll(ctx, pollID)
+	if err != nil {
+		return domain.Sheet{}, err
+	}
+
+	sheet, err := m.sheetRepository.GetByID(ctx, poll.SheetID.Hex())
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return domain.Sheet{}, domain.ErrPollNotFound
+	}
+	return sheet, err
+}
+
+func (m mediaUsecase) UploadOptionMedia(c context.Context, pollID string, option int, upload domain.MediaUpload) (domain.Poll, error) {
+	ctx, cancel := context.WithTimeout(c, m.contextTimeout)
+	defer cancel()
+
+	if upload.Size > domain.MaxMediaSize {
+		return domain.Poll{}, domain.ErrMediaTooLarge
+	}
+
+	poll, err := m.getPoll(ctx, pollID)
+	if err != nil {
+		return domain.Poll{}, err
+	}
+	if option < 0 || option >= len(poll.Options) {
+		return domain.Poll{}, domain.ErrInvalidMediaOption
+	}
+
+	// Trust the content rather than the client-supplied type or file name.
+	content := bufio.NewReaderSize(io.LimitReader(upload.Content, domain.MaxMediaSize+1), 512)
+	head, err := content.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return domain.Poll{}, err
+	}
+	contentType := http.DetectContentType(head)
+	extension, ok := domain.MediaExtension(contentType)
+	if !ok {
+		return domain.Poll{}, domain.ErrUnsupportedMediaType
+	}
+
+	counted := &countingReader{reader: content}
+	media := domain.OptionMedia{
+		Option:      option,
+		Key:         primitive.NewObjectID().Hex() + extension,
+		FileName:    filepath.Base(upload.FileName),
+		ContentType: contentType,
+	}
+	if err = m.store.Put(ctx, media.Key, counted); err != nil {
+		return domain.Poll{}, err
+	}
+	if counted.count > domain.MaxMediaSize {
+		_ = m.store.Delete(ctx, media.Key)
+		return domain.Poll{}, domain.ErrMediaTooLarge
+	}
+	media.Size = counted.count
+
+	updated, replaced := poll.WithOptionMedia(media)
+	previousKey := ""
+	if replaced != nil {
+		previousKey = replaced.Key
+	}
+	if err = m.pollRepository.SetOptionMedia(ctx, pollID, media, previousKey); err != nil {
+		_ = m.store.Delete(ctx, media.Key)
+		return domain.Poll{}, err
+	}
+	if replaced != nil {
+		_ = m.store.Delete(ctx, replaced.Key)
+	}
+
+	poll.OptionMedia = updated
+	return poll, nil
+}
+
+func (m mediaUsecase) RemoveOptionMedia(c context.Context, pollID string, option int) (domain.Poll, error) {
+	ctx, cancel := context.WithTimeout(c, m.contextTimeout)
+	defer cancel()
+
+	poll, err := m.getPoll(ctx, pollID)
+	if err != nil {
+		return domain.Poll{}, err
+	}
+
+	remaining := make([]domain.OptionMedia, 0, len(poll.OptionMedia))
+	var removed *domain.OptionMedia
+	for _, media := range poll.OptionMedia {
+		if media.Option == option {
+			previous := media
+			removed = &previous
+			continue
+		}
+		remaining = append(remaining, media)
+	}
+	if removed == nil {
+		return domain.Poll{}, domain.ErrMediaNotFound
+	}
+
+	if err = m.pollRepository.RemoveOptionMedia(ctx, pollID, option, removed.Key); err != nil {
+		return domain.Poll{}, err
+	}
+	_ = m.store.Delete(ctx, removed.Key)
+
+	poll.OptionMedia = remaining
+	return poll, nil
+}
+
+func (m mediaUsecase) Open(c context.Context, key string) (io.ReadCloser, error) {
+	// The returned reader outlives this call, so the request context governs it.
+	return m.store.Open(c, key)
+}
+
+func (m mediaUsecase) getPoll(ctx context.Context, pollID string) (domain.Poll, error) {
+	poll, err := m.pollRepository.GetByID(ctx, pollID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return domain.Poll{}, domain.ErrPollNotFound
+	}
+	return poll, err
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
+
+func NewMediaUsecase(pollRepository domain.PollRepository, sheetRepository domain.SheetRepository, store domain.BlobStore, timeout time.Duration) domain.MediaUsecase {
+	return &mediaUsecase{
+		pollRepository:  pollRepository,
+		sheetRepository: sheetRepository,
+		store:           store,
+		contextTimeout:  timeout,
+	}
+}
@@ -4,31 +4,140 @@ import (
 	"context"
 	"errors"
 	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
 	"time"
 )
 
 type pollAdminUsecase struct {
-	repository     domain.PollRepository
-	contextTimeout time.Duration
+	repository       domain.PollRepository
+	ballotRepository domain.BallotRepository
+	sheetRepository  domain.SheetRepository
+	blobStore        domain.BlobStore
+	client           mongo.Client
+	contextTimeout   time.Duration
 }
 
-func (p pollAdminUsecase) Delete(c context.Context, id string) error {
+// Delete moves a poll to the trash; its ballots are kept until the trash is purged.
+func (p pollAdminUsecase) Delete(c context.Context, id string, deletedBy primitive.ObjectID, deletedAt time.Time) error {
 	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
 	defer cancel()
 
-	err := p.repository.Delete(ctx, id)
+	err := p.repository.SoftDelete(ctx, id, deletedBy, deletedAt)
 
 	if errors.Is(err, domain.ErrPollNotFound) {
-		return p.repository.DeleteBySheetID(ctx, id)
+		var trashed int64
+		trashed, err = p.repository.SoftDeleteBySheetID(ctx, id, deletedBy, deletedAt)
+		if err == nil && trashed == 0 {
+			return domain.ErrPollNotFound
+		}
 	}
 
 	return err
 }
 
+func (p pollAdminUsecase) GetTrash(c context.Context, sheetID string) ([]domain.Poll, error) {
+	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
+	defer cancel()
+
+	polls, err := p.repository.GetDeletedBySheetID(ctx, sheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.loadSlideValues(ctx, polls); err != nil {
+		return nil, err
+	}
+
+	return polls, nil
+}
+
+func (p pollAdminUsecase) GetByID(c context.Context, id string) (domain.Poll, error) {
+	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
+	defer cancel()
+	return p.repository.GetByID(ctx, id)
+}
+
+func (p pollAdminUsecase) GetTrashedByID(c context.Context, id string) (domain.Poll, error) {
+	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
+	defer cancel()
+
+	poll, err := p.repository.GetDeletedByID(ctx, id)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return domain.Poll{}, domain.ErrPollNotFound
+	}
+	return poll, err
+}
+
+// Restore takes a poll out of the trash. Polls of a trashed sheet come back with the sheet.
+func (p pollAdminUsecase) Restore(c context.Context, id string, restoredAt time.Time) (domain.Poll, error) {
+	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
+	defer cancel()
+
+	poll, err := p.repository.GetDeletedByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongodriver.ErrNoDocuments) {
+			return domain.Poll{}, domain.ErrPollNotFound
+		}
+		return domain.Poll{}, err
+	}
+
+	if _, err = p.sheetRepository.GetByID(ctx, poll.SheetID.Hex()); err != nil {
+		if errors.Is(err, mongodriver.ErrNoDocuments) {
+			return domain.Poll{}, domain.ErrSheetInTrash
+		}
+		return domain.Poll{}, err
+	}
+
+	if err = p.repository.Restore(ctx, id, restoredAt); err != nil {
+		return domain.Poll{}, err
+	}
+
+	poll.DeletedAt = nil
+	poll.DeletedBy = primitive.NilObjectID
+	poll.UpdatedAt = restoredAt
+
+	polls := []domain.Poll{poll}
+	if err = p.loadSlideValues(ctx, polls); err != nil {
+		return domain.Poll{}, err
+	}
+
+	return polls[0], nil
+}
+
+// GetSheet returns the sheet a poll belongs to, whether or not the sheet is in the trash.
+func (p pollAdminUsecase) GetSheet(c context.Context, sheetID string) (domain.Sheet, error) {
+	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
+	defer cancel()
+
+	sheet, err := p.sheetRepository.GetByID(ctx, sheetID)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return p.sheetRepository.GetDeletedByID(ctx, sheetID)
+	}
+	return sheet, err
+}
+
 func (p pollAdminUsecase) CreatePoll(c context.Context, poll *domain.Poll) error {
 	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
 	defer cancel()
 
+	if poll.Position == 0 {
+		// Polls added one by one go after the sheet's existing polls.
+		existing, _, err := p.repository.GetPollBySheetID(ctx, poll.SheetID.Hex(), domain.PaginationQuery{})
+		if err != nil {
+			return err
+		}
+		for _, other := range existing {
+			if other.Position >= poll.Position {
+				poll.Position = other.Position + 1
+			}
+		}
+		if poll.Position == 0 {
+			poll.Position = 1
+		}
+	}
+
 	err := p.repository.Create(ctx, poll)
 
 	return err
@@ -38,21 +147,232 @@ func (p pollAdminUsecase) GetBySheetID(c context.Context, sheetID string, pagina
 	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
 	defer cancel()
 
-	return p.repository.GetPollBySheetID(ctx, sheetID, pagination)
+	polls, total, err := p.repository.GetPollBySheetID(ctx, sheetID, pagination)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err = p.loadSlideValues(ctx, polls); err != nil {
+		return nil, 0, err
+	}
+
+	return polls, total, nil
 }
 
 func (p pollAdminUsecase) EditPoll(c context.Context, poll *domain.Poll) error {
 	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
 	defer cancel()
 
-	err := p.repository.EditPoll(ctx, poll)
+	existing, err := p.repository.GetByID(ctx, poll.ID.Hex())
+	if err != nil {
+		return err
+	}
 
-	return err
+	// Ballots only stay meaningful while the poll keeps its type, options, rows and answer
+	// key; a poll without responses may change shape and starts over with empty counters.
+	if existing.SameShape(*poll) {
+		poll.CopyAggregates(existing)
+	} else {
+		if existing.Participant > 0 {
+			return domain.ErrPollHasResponses
+		}
+		poll.ResetAggregates()
+	}
+
+	// Attachments belong to option labels, not to indices.
+	var dropped []domain.OptionMedia
+	poll.OptionMedia, dropped = domain.RemapOptionMedia(existing.OptionMedia, existing.Options, poll.Options)
+
+	if err = p.repository.EditPoll(ctx, poll); err != nil {
+		return err
+	}
+
+	for _, media := range dropped {
+		_ = p.blobStore.Delete(ctx, media.Key)
+	}
+	return nil
 }
 
-func NewPollAdminUsecase(repository domain.PollRepository, timeout time.Duration) domain.PollAdminUsecase {
+func (p pollAdminUsecase) GetBallots(c context.Context, pollID string, pagination domain.PaginationQuery) ([]domain.Ballot, int64, error) {
+	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
+	defer cancel()
+
+	return p.ballotRepository.GetByPollID(ctx, pollID, pagination)
+}
+
+func (p pollAdminUsecase) GetBallot(c context.Context, id string) (domain.Ballot, error) {
+	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
+	defer cancel()
+
+	ballot, err := p.ballotRepository.GetByID(ctx, id)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return domain.Ballot{}, domain.ErrBallotNotFound
+	}
+	return ballot, err
+}
+
+func (p pollAdminUsecase) DeleteBallot(c context.Context, id string) (domain.Poll, error) {
+	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
+	defer cancel()
+
+	var poll domain.Poll
+	err := withTransaction(ctx, p.client, func(txCtx context.Context) error {
+		ballot, err := p.ballotRepository.GetByID(txCtx, id)
+		if err != nil {
+			if errors.Is(err, mongodriver.ErrNoDocuments) {
+				return domain.ErrBallotNotFound
+			}
+			return err
+		}
+
+		// Ballots of trashed polls stay until the trash is purged.
+		poll, err = p.livePoll(txCtx, ballot.PollID.Hex())
+		if err != nil {
+			return err
+		}
+
+		if err = p.ballotRepository.Delete(txCtx, id); err != nil {
+			return err
+		}
+
+		return p.recount(txCtx, &poll)
+	})
+	if err != nil {
+		return domain.Poll{}, err
+	}
+
+	return poll, nil
+}
+
+func (p pollAdminUsecase) Recount(c context.Context, pollID string) (domain.Poll, error) {
+	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
+	defer cancel()
+
+	var poll domain.Poll
+	err := withTransaction(ctx, p.client, func(txCtx context.Context) error {
+		var err error
+		poll, err = p.livePoll(txCtx, pollID)
+		if err != nil {
+			return err
+		}
+		return p.recount(txCtx, &poll)
+	})
+	if err != nil {
+		return domain.Poll{}, err
+	}
+
+	return poll, nil
+}
+
+// livePoll loads a poll that is not in the trash, or returns ErrPollNotFound.
+func (p pollAdminUsecase) livePoll(ctx context.Context, pollID string) (domain.Poll, error) {
+	poll, err := p.repository.GetByID(ctx, pollID)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return domain.Poll{}, domain.ErrPollNotFound
+	}
+	return poll, err
+}
+
+// recount rebuilds the poll's vote counters from its stored ballots. Run it in the
+// transaction that changed the ballots, so that votes cast meanwhile are not lost.
+func (p pollAdminUsecase) recount(ctx context.Context, poll *domain.Poll) error {
+	ballots, _, err := p.ballotRepository.GetByPollID(ctx, poll.ID.Hex(), domain.PaginationQuery{})
+	if err != nil {
+		return err
+	}
+
+	poll.ResetAggregates()
+	for _, ballot := range ballots {
+		poll.ApplyBallot(ballot)
+	}
+
+	return p.repository.UpdateAggregates(ctx, poll)
+}
+
+func (p pollAdminUsecase) GetLeaderboard(c context.Context, sheetID string) ([]domain.QuizLeaderboardEntry, error) {
+	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
+	defer cancel()
+
+	polls, _, err := p.repository.GetPollBySheetID(ctx, sheetID, domain.PaginationQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	ballots, err := p.ballotRepository.GetByPollIDs(ctx, pollIDs(polls))
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.BuildLeaderboard(ballots), nil
+}
+
+func (p pollAdminUsecase) Reorder(c context.Context, sheetID string, pollIDs []string) ([]domain.Poll, error) {
+	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
+	defer cancel()
+
+	polls, _, err := p.repository.GetPollBySheetID(ctx, sheetID, domain.PaginationQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	reordered, err := domain.ReorderPolls(polls, pollIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = withTransaction(ctx, p.client, func(txCtx context.Context) error {
+		return p.repository.UpdatePositions(txCtx, reordered)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.loadSlideValues(ctx, reordered); err != nil {
+		return nil, err
+	}
+
+	return reordered, nil
+}
+
+// loadSlideValues fills in the submitted values of the slide polls among polls, which
+// their statistics are computed from.
+func (p pollAdminUsecase) loadSlideValues(ctx context.Context, polls []domain.Poll) error {
+	pollIDs := make([]string, 0)
+	for _, poll := range polls {
+		if poll.PollType == domain.PollTypeSlide {
+			pollIDs = append(pollIDs, poll.ID.Hex())
+		}
+	}
+	if len(pollIDs) == 0 {
+		return nil
+	}
+
+	values, err := p.ballotRepository.GetValuesByPollIDs(ctx, pollIDs)
+	if err != nil {
+		return err
+	}
+
+	for idx := range polls {
+		polls[idx].SlideValues = values[polls[idx].ID.Hex()]
+	}
+	return nil
+}
+
+func NewPollAdminUsecase(repository domain.PollRepository, ballotRepository domain.BallotRepository, sheetRepository domain.SheetRepository, blobStore domain.BlobStore, client mongo.Client, timeout time.Duration) domain.PollAdminUsecase {
 	return &pollAdminUsecase{
-		repository:     repository,
-		contextTimeout: timeout,
+		repository:       repository,
+		ballotRepository: ballotRepository,
+		sheetRepository:  sheetRepository,
+		blobStore:        blobStore,
+		client:           client,
+		contextTimeout:   timeout,
+	}
+}
+
+func pollIDs(polls []domain.Poll) []string {
+	ids := make([]string, 0, len(polls))
+	for _, poll := range polls {
+		ids = append(ids, poll.ID.Hex())
 	}
+	return ids
 }
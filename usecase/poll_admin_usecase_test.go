@@ -0,0 +1,201 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain/mocks"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestRecount(t *testing.T) {
+	poll := domain.Poll{ID: primitive.NewObjectID(), PollType: domain.PollTypeSingleChoice, Options: []string{"A", "B"}, Participant: 7, Votes: []int{4, 3}}
+	ballots := []domain.Ballot{{Options: []int{0}}, {Options: []int{1}}, {Options: []int{1}}}
+
+	tests := []struct {
+		name       string
+		getErr     error
+		ballotsErr error
+		updateErr  error
+		wantErr    error
+		wantVotes  []int
+	}{
+		{name: "rebuilds counters", wantVotes: []int{1, 2}},
+		{name: "poll not found", getErr: mongodriver.ErrNoDocuments, wantErr: domain.ErrPollNotFound},
+		{name: "ballots unavailable", ballotsErr: errors.New("read failed"), wantErr: errors.New("read failed")},
+		{name: "update fails", updateErr: errors.New("write failed"), wantErr: errors.New("write failed")},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockPollRepository := new(mocks.PollRepository)
+			mockBallotRepository := new(mocks.BallotRepository)
+			tx := &transaction{}
+
+			mockPollRepository.On("GetByID", mock.Anything, poll.ID.Hex()).Return(poll, tc.getErr)
+			mockBallotRepository.On("GetByPollID", mock.Anything, poll.ID.Hex(), domain.PaginationQuery{}).Return(ballots, int64(len(ballots)), tc.ballotsErr).Maybe()
+			mockPollRepository.On("UpdateAggregates", mock.Anything, mock.AnythingOfType("*domain.Poll")).Return(tc.updateErr).Maybe()
+
+			u := usecase.NewPollAdminUsecase(mockPollRepository, mockBallotRepository, new(mocks.SheetRepository), new(mocks.BlobStore), newTransactionClient(tx), time.Second)
+			recounted, err := u.Recount(context.Background(), poll.ID.Hex())
+
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				assert.Equal(t, 1, tx.aborted)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, tx.committed)
+			assert.Equal(t, len(ballots), recounted.Participant)
+			assert.Equal(t, tc.wantVotes, recounted.Votes)
+			mockPollRepository.AssertCalled(t, "UpdateAggregates", mock.Anything, mock.MatchedBy(func(updated *domain.Poll) bool {
+				return updated.Participant == len(ballots) && assert.ObjectsAreEqual(tc.wantVotes, updated.Votes)
+			}))
+		})
+	}
+}
+
+func TestDeleteBallot(t *testing.T) {
+	poll := domain.Poll{ID: primitive.NewObjectID(), PollType: domain.PollTypeSingleChoice, Options: []string{"A", "B"}, Participant: 2, Votes: []int{1, 1}}
+	ballot := domain.Ballot{ID: primitive.NewObjectID(), PollID: poll.ID, Options: []int{0}}
+	remaining := []domain.Ballot{{Options: []int{1}}}
+
+	t.Run("success", func(t *testing.T) {
+		mockPollRepository := new(mocks.PollRepository)
+		mockBallotRepository := new(mocks.BallotRepository)
+		tx := &transaction{}
+
+		mockBallotRepository.On("GetByID", mock.Anything, ballot.ID.Hex()).Return(ballot, nil)
+		mockPollRepository.On("GetByID", mock.Anything, poll.ID.Hex()).Return(poll, nil)
+		mockBallotRepository.On("Delete", mock.Anything, ballot.ID.Hex()).Return(nil)
+		mockBallotRepository.On("GetByPollID", mock.Anything, poll.ID.Hex(), domain.PaginationQuery{}).Return(remaining, int64(1), nil)
+		mockPollRepository.On("UpdateAggregates", mock.Anything, mock.AnythingOfType("*domain.Poll")).Return(nil)
+
+		u := usecase.NewPollAdminUsecase(mockPollRepository, mockBallotRepository, new(mocks.SheetRepository), new(mocks.BlobStore), newTransactionClient(tx), time.Second)
+		recounted, err := u.DeleteBallot(context.Background(), ballot.ID.Hex())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, tx.committed)
+		assert.Equal(t, 1, recounted.Participant)
+		assert.Equal(t, []int{0, 1}, recounted.Votes)
+		mockBallotRepository.AssertExpectations(t)
+		mockPollRepository.AssertExpectations(t)
+	})
+
+	t.Run("poll in trash", func(t *testing.T) {
+		mockPollRepository := new(mocks.PollRepository)
+		mockBallotRepository := new(mocks.BallotRepository)
+		tx := &transaction{}
+
+		mockBallotRepository.On("GetByID", mock.Anything, ballot.ID.Hex()).Return(ballot, nil)
+		mockPollRepository.On("GetByID", mock.Anything, poll.ID.Hex()).Return(domain.Poll{}, mongodriver.ErrNoDocuments)
+
+		u := usecase.NewPollAdminUsecase(mockPollRepository, mockBallotRepository, new(mocks.SheetRepository), new(mocks.BlobStore), newTransactionClient(tx), time.Second)
+		_, err := u.DeleteBallot(context.Background(), ballot.ID.Hex())
+
+		assert.ErrorIs(t, err, domain.ErrPollNotFound)
+		assert.Equal(t, 1, tx.aborted)
+		mockBallotRepository.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("recount fails", func(t *testing.T) {
+		mockPollRepository := new(mocks.PollRepository)
+		mockBallotRepository := new(mocks.BallotRepository)
+		tx := &transaction{}
+
+		mockBallotRepository.On("GetByID", mock.Anything, ballot.ID.Hex()).Return(ballot, nil)
+		mockPollRepository.On("GetByID", mock.Anything, poll.ID.Hex()).Return(poll, nil)
+		mockBallotRepository.On("Delete", mock.Anything, ballot.ID.Hex()).Return(nil)
+		mockBallotRepository.On("GetByPollID", mock.Anything, poll.ID.Hex(), domain.PaginationQuery{}).Return(remaining, int64(1), nil)
+		mockPollRepository.On("UpdateAggregates", mock.Anything, mock.AnythingOfType("*domain.Poll")).Return(errors.New("write failed"))
+
+		u := usecase.NewPollAdminUsecase(mockPollRepository, mockBallotRepository, new(mocks.SheetRepository), new(mocks.BlobStore), newTransactionClient(tx), time.Second)
+		_, err := u.DeleteBallot(context.Background(), ballot.ID.Hex())
+
+		// The delete ran in the aborted transaction, so the ballot is kept.
+		assert.EqualError(t, err, "write failed")
+		assert.Equal(t, 0, tx.committed)
+		assert.Equal(t, 1, tx.aborted)
+	})
+
+	t.Run("ballot not found", func(t *testing.T) {
+		mockBallotRepository := new(mocks.BallotRepository)
+		mockBallotRepository.On("GetByID", mock.Anything, ballot.ID.Hex()).Return(domain.Ballot{}, mongodriver.ErrNoDocuments)
+
+		u := usecase.NewPollAdminUsecase(new(mocks.PollRepository), mockBallotRepository, new(mocks.SheetRepository), new(mocks.BlobStore), newTransactionClient(&transaction{}), time.Second)
+		_, err := u.DeleteBallot(context.Background(), ballot.ID.Hex())
+
+		assert.ErrorIs(t, err, domain.ErrBallotNotFound)
+	})
+}
+
+func TestEditPollAnswerKey(t *testing.T) {
+	existing := domain.Poll{ID: primitive.NewObjectID(), PollType: domain.PollTypeSingleChoice, Options: []string{"A", "B"}, CorrectOptions: []int{0}, Points: 1, Participant: 3, CorrectCount: 2, Votes: []int{2, 1}}
+
+	t.Run("with responses", func(t *testing.T) {
+		mockPollRepository := new(mocks.PollRepository)
+		mockPollRepository.On("GetByID", mock.Anything, existing.ID.Hex()).Return(existing, nil)
+
+		edited := existing
+		edited.CorrectOptions = []int{1}
+
+		u := usecase.NewPollAdminUsecase(mockPollRepository, new(mocks.BallotRepository), new(mocks.SheetRepository), new(mocks.BlobStore), newTransactionClient(&transaction{}), time.Second)
+		err := u.EditPoll(context.Background(), &edited)
+
+		assert.ErrorIs(t, err, domain.ErrPollHasResponses)
+		mockPollRepository.AssertNotCalled(t, "EditPoll", mock.Anything, mock.Anything)
+	})
+
+	t.Run("without responses", func(t *testing.T) {
+		unanswered := existing
+		unanswered.Participant, unanswered.CorrectCount, unanswered.Votes = 0, 0, []int{0, 0}
+
+		mockPollRepository := new(mocks.PollRepository)
+		mockPollRepository.On("GetByID", mock.Anything, existing.ID.Hex()).Return(unanswered, nil)
+		mockPollRepository.On("EditPoll", mock.Anything, mock.MatchedBy(func(poll *domain.Poll) bool {
+			return poll.Points == 5 && poll.CorrectCount == 0
+		})).Return(nil)
+
+		edited := unanswered
+		edited.Points = 5
+
+		u := usecase.NewPollAdminUsecase(mockPollRepository, new(mocks.BallotRepository), new(mocks.SheetRepository), new(mocks.BlobStore), newTransactionClient(&transaction{}), time.Second)
+		assert.NoError(t, u.EditPoll(context.Background(), &edited))
+		mockPollRepository.AssertExpectations(t)
+	})
+}
+
+func TestEditPollOptionMedia(t *testing.T) {
+	existing := domain.Poll{
+		ID:          primitive.NewObjectID(),
+		PollType:    domain.PollTypeSingleChoice,
+		Options:     []string{"A", "B"},
+		Votes:       []int{0, 0},
+		OptionMedia: []domain.OptionMedia{{Option: 0, Key: "a.png"}, {Option: 1, Key: "b.png"}},
+	}
+
+	mockPollRepository := new(mocks.PollRepository)
+	mockBlobStore := new(mocks.BlobStore)
+	mockPollRepository.On("GetByID", mock.Anything, existing.ID.Hex()).Return(existing, nil)
+	mockPollRepository.On("EditPoll", mock.Anything, mock.MatchedBy(func(poll *domain.Poll) bool {
+		return assert.ObjectsAreEqual([]domain.OptionMedia{{Option: 1, Key: "b.png"}}, poll.OptionMedia)
+	})).Return(nil)
+	mockBlobStore.On("Delete", mock.Anything, "a.png").Return(nil)
+
+	edited := domain.Poll{ID: existing.ID, PollType: domain.PollTypeSingleChoice, Options: []string{"C", "B"}}
+
+	u := usecase.NewPollAdminUsecase(mockPollRepository, new(mocks.BallotRepository), new(mocks.SheetRepository), mockBlobStore, newTransactionClient(&transaction{}), time.Second)
+	assert.NoError(t, u.EditPoll(context.Background(), &edited))
+
+	mockPollRepository.AssertExpectations(t)
+	mockBlobStore.AssertExpectations(t)
+}
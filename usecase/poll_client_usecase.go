@@ -2,45 +2,305 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/internal/tokenutil"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/internal/validation"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"strings"
 	"time"
 )
 
 type pollClientUsecase struct {
-	repository      domain.PollRepository
-	sheetRepository domain.SheetRepository
-	contextTimeout  time.Duration
+	repository       domain.PollRepository
+	sheetRepository  domain.SheetRepository
+	ballotRepository domain.BallotRepository
+	client           mongo.Client
+	contextTimeout   time.Duration
 }
 
-func (p pollClientUsecase) SubmitVote(c context.Context, payload domain.PollClientRequest) error {
+type respondent struct {
+	key    string
+	seed   string
+	phone  string
+	client domain.BallotClient
+}
+
+func (p pollClientUsecase) SubmitVote(c context.Context, payload domain.PollClientRequest) (*domain.QuizScore, error) {
 	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
 	defer cancel()
 
 	poll, err := p.repository.GetByID(ctx, payload.ID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	sheet, err := p.sheetRepository.GetByID(ctx, poll.SheetID.Hex())
+	if err != nil {
+		return nil, err
+	}
+	if err = sheet.AcceptsResponses(time.Now()); err != nil {
+		return nil, err
+	}
+
+	who, err := resolveRespondent(sheet, payload.Phone, payload.RespondentID, payload.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	answer := domain.PollClientAnswer{ID: payload.ID, Votes: payload.Votes, Inputs: payload.Inputs, Value: payload.Value, Matrix: payload.Matrix}
+	ballot, err := p.prepareBallot(sheet, poll, who, answer, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// A poll behind a display rule is only shown once the respondent's stored answer to
+	// the rule's poll selects one of its trigger options.
+	if poll.ShowIf != nil {
+		previous, err := p.ballotRepository.GetByRespondent(ctx, sheet.ID.Hex(), who.key)
+		if err != nil {
+			return nil, err
+		}
+		selections := make(map[primitive.ObjectID][]int, len(previous))
+		for _, stored := range previous {
+			selections[stored.PollID] = stored.Options
+		}
+		if !poll.IsVisible(selections) {
+			return nil, domain.ErrPollHidden
+		}
+	}
+
+	err = withTransaction(ctx, p.client, func(txCtx context.Context) error {
+		return p.recordBallot(txCtx, poll, ballot)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.quizScore(ctx, sheet, who.key, nil)
+}
+
+func (p pollClientUsecase) SubmitSheet(c context.Context, payload domain.PollClientSheetRequest) (*domain.QuizScore, error) {
+	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
+	defer cancel()
+
+	if len(payload.Answers) == 0 {
+		return nil, domain.ErrNoAnswersSubmitted
+	}
+
+	sheet, err := p.sheetRepository.GetByID(ctx, payload.SheetID)
+	if err != nil {
+		return nil, err
+	}
+	if err = sheet.AcceptsResponses(time.Now()); err != nil {
+		return nil, err
+	}
+
+	polls, _, err := p.repository.GetPollBySheetID(ctx, payload.SheetID, domain.PaginationQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	who, err := resolveRespondent(sheet, payload.Phone, payload.RespondentID, payload.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	pollsByID := make(map[string]domain.Poll, len(polls))
+	for _, poll := range polls {
+		pollsByID[poll.ID.Hex()] = poll
+	}
+
+	now := time.Now()
+	answered := make(map[string]struct{}, len(payload.Answers))
+	ballots := make([]domain.Ballot, 0, len(payload.Answers))
+	answeredPolls := make([]domain.Poll, 0, len(payload.Answers))
+	for _, answer := range payload.Answers {
+		poll, ok := pollsByID[answer.ID]
+		if !ok {
+			return nil, fmt.Errorf("poll %s: %w", answer.ID, domain.ErrPollNotInSheet)
+		}
+		if _, ok = answered[answer.ID]; ok {
+			return nil, fmt.Errorf("poll %s: %w", answer.ID, domain.ErrDuplicateAnswer)
+		}
+		answered[answer.ID] = struct{}{}
+
+		ballot, err := p.prepareBallot(sheet, poll, who, answer, now)
+		if err != nil {
+			return nil, fmt.Errorf("poll %s: %w", answer.ID, err)
+		}
+		ballots = append(ballots, ballot)
+		answeredPolls = append(answeredPolls, poll)
+	}
+
+	selections := make(map[primitive.ObjectID][]int, len(ballots))
+	for _, ballot := range ballots {
+		selections[ballot.PollID] = ballot.Options
+	}
+	for _, poll := range answeredPolls {
+		if !poll.IsVisible(selections) {
+			return nil, fmt.Errorf("poll %s: %w", poll.ID.Hex(), domain.ErrPollHidden)
+		}
+	}
+	if err = domain.CheckRequiredPolls(polls, selections); err != nil {
+		return nil, err
+	}
+
+	err = withTransaction(ctx, p.client, func(txCtx context.Context) error {
+		for idx, ballot := range ballots {
+			if err := p.recordBallot(txCtx, answeredPolls[idx], ballot); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.quizScore(ctx, sheet, who.key, polls)
+}
+
+// prepareBallot validates a respondent's answer to one poll and builds the ballot to store.
+func (p pollClientUsecase) prepareBallot(sheet domain.Sheet, poll domain.Poll, who respondent, answer domain.PollClientAnswer, submittedAt time.Time) (domain.Ballot, error) {
+	// Respondents answer against their own option order; ballots store canonical indices.
+	// Without a respondent token there is no order to map the answer back from.
+	if poll.ShuffleOptions && who.seed == "" {
+		return domain.Ballot{}, domain.ErrRespondentRequired
+	}
+	answer, err := poll.CanonicalAnswer(answer, who.seed)
+	if err != nil {
+		return domain.Ballot{}, err
+	}
+
+	ballot := domain.Ballot{
+		ID:            primitive.NewObjectID(),
+		SheetID:       poll.SheetID,
+		PollID:        poll.ID,
+		RespondentKey: who.key,
+		Unique:        sheet.DedupMode != "" && sheet.DedupMode != domain.DedupNone,
+		Phone:         who.phone,
+		Client:        who.client,
+		CreatedAt:     submittedAt,
 	}
 
-	switch poll.PollType {
-	case domain.PollTypeOpinion:
-		inputs := make([]string, 0, len(payload.Inputs))
-		for _, input := range payload.Inputs {
+	switch {
+	case poll.PollType == domain.PollTypeOpinion:
+		texts := make([]string, 0, len(answer.Inputs))
+		for _, input := range answer.Inputs {
 			value := strings.TrimSpace(input)
 			if value != "" {
-				inputs = append(inputs, value)
+				texts = append(texts, value)
 			}
 		}
-		if len(inputs) == 0 {
-			return domain.ErrNoOpinionSubmitted
+		if len(texts) == 0 {
+			return domain.Ballot{}, domain.ErrNoOpinionSubmitted
+		}
+		ballot.Text = texts
+	case poll.PollType == domain.PollTypeSlide && poll.Slide != nil:
+		value, err := poll.Slide.ValidateValue(answer.Value)
+		if err != nil {
+			return domain.Ballot{}, err
 		}
-		return p.repository.AppendOpinionResponse(ctx, payload.ID, inputs)
+		ballot.Value = &value
+	case poll.PollType == domain.PollTypeMatrix:
+		columns, err := domain.ValidateMatrix(answer.Matrix, len(poll.Rows), len(poll.Options))
+		if err != nil {
+			return domain.Ballot{}, err
+		}
+		ballot.Options = columns
 	default:
-		if len(payload.Votes) == 0 {
-			return domain.ErrNoVotesSubmitted
+		selected, err := poll.PollType.ValidateVotes(answer.Votes, len(poll.Options))
+		if err != nil {
+			return domain.Ballot{}, err
+		}
+		ballot.Options = selected
+
+		if err = poll.ValidateSelectionCount(selected); err != nil {
+			return domain.Ballot{}, err
+		}
+
+		other, err := poll.OtherText(selected, answer.Inputs)
+		if err != nil {
+			return domain.Ballot{}, err
+		}
+		if other != "" {
+			ballot.Text = []string{other}
+		}
+
+		if sheet.IsQuiz && poll.IsGradable() {
+			correct := poll.Grade(selected)
+			ballot.Correct = &correct
+			if correct {
+				ballot.Points = poll.Points
+			}
 		}
-		return p.repository.SubmitVote(ctx, payload.ID, payload.Votes)
 	}
+
+	return ballot, nil
+}
+
+// recordBallot stores the ballot and folds it into the poll's counters.
+func (p pollClientUsecase) recordBallot(ctx context.Context, poll domain.Poll, ballot domain.Ballot) error {
+	if err := p.ballotRepository.Create(ctx, &ballot); err != nil {
+		return err
+	}
+
+	pollID := ballot.PollID.Hex()
+
+	var err error
+	switch {
+	case poll.PollType == domain.PollTypeOpinion:
+		err = p.repository.AppendOpinionResponse(ctx, pollID, ballot.Text)
+	case poll.PollType == domain.PollTypeRanking:
+		err = p.repository.SubmitRanking(ctx, pollID, ballot.Options)
+	case poll.PollType == domain.PollTypeMatrix:
+		err = p.repository.SubmitMatrix(ctx, pollID, ballot.Options)
+	case ballot.Value != nil && poll.Slide != nil:
+		err = p.repository.SubmitSlideValue(ctx, pollID, poll.Slide.Bucket(*ballot.Value))
+	default:
+		err = p.repository.SubmitVote(ctx, pollID, ballot.Options)
+	}
+	if err != nil {
+		return err
+	}
+
+	if poll.AllowOther && len(ballot.Text) > 0 {
+		if err = p.repository.AppendOtherResponse(ctx, pollID, ballot.Text[0]); err != nil {
+			return err
+		}
+	}
+
+	if ballot.Correct != nil && *ballot.Correct {
+		return p.repository.IncrementCorrect(ctx, pollID)
+	}
+	return nil
+}
+
+// quizScore totals the respondent's quiz result once every poll of a quiz sheet has been
+// answered; it returns nil for regular sheets and incomplete quizzes.
+func (p pollClientUsecase) quizScore(ctx context.Context, sheet domain.Sheet, respondentKey string, polls []domain.Poll) (*domain.QuizScore, error) {
+	if !sheet.IsQuiz {
+		return nil, nil
+	}
+
+	if polls == nil {
+		var err error
+		polls, _, err = p.repository.GetPollBySheetID(ctx, sheet.ID.Hex(), domain.PaginationQuery{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ballots, err := p.ballotRepository.GetByRespondent(ctx, sheet.ID.Hex(), respondentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ScoreQuiz(polls, ballots), nil
 }
 
 func (p pollClientUsecase) GetBySheetID(c context.Context, sheetID string, pagination domain.PaginationQuery) ([]domain.Poll, int64, error) {
@@ -50,11 +310,13 @@ func (p pollClientUsecase) GetBySheetID(c context.Context, sheetID string, pagin
 	return p.repository.GetPollBySheetID(ctx, sheetID, pagination)
 }
 
-func NewPollClientUsecase(repo domain.PollRepository, sheetRepo domain.SheetRepository, timeout time.Duration) domain.PollClientUsecase {
+func NewPollClientUsecase(repo domain.PollRepository, sheetRepo domain.SheetRepository, ballotRepo domain.BallotRepository, client mongo.Client, timeout time.Duration) domain.PollClientUsecase {
 	return &pollClientUsecase{
-		repository:      repo,
-		sheetRepository: sheetRepo,
-		contextTimeout:  timeout,
+		repository:       repo,
+		sheetRepository:  sheetRepo,
+		ballotRepository: ballotRepo,
+		client:           client,
+		contextTimeout:   timeout,
 	}
 }
 
@@ -62,5 +324,65 @@ func (p pollClientUsecase) GetSheet(c context.Context, sheetID string) (domain.S
 	ctx, cancel := context.WithTimeout(c, p.contextTimeout)
 	defer cancel()
 
-	return p.sheetRepository.GetByID(ctx, sheetID)
+	sheet, err := p.sheetRepository.GetByID(ctx, sheetID)
+	if err != nil {
+		return sheet, err
+	}
+
+	if err = sheet.AcceptsResponses(time.Now()); err != nil {
+		return domain.Sheet{}, err
+	}
+
+	return sheet, nil
+}
+
+func (p pollClientUsecase) CreateRespondentToken(secret string, expiry int) (respondentToken string, err error) {
+	return tokenutil.CreateRespondentToken(primitive.NewObjectID().Hex(), secret, expiry)
+}
+
+func (p pollClientUsecase) ExtractRespondentIDFromToken(respondentToken string, secret string) (string, error) {
+	return tokenutil.ExtractIDFromToken(respondentToken, secret)
+}
+
+// resolveRespondent checks the respondent's phone against the sheet settings and derives
+// the key used to recognise repeat submissions.
+func resolveRespondent(sheet domain.Sheet, phone string, respondentID string, client domain.BallotClient) (respondent, error) {
+	phone = strings.TrimSpace(phone)
+	if phone == "" && sheet.IsPhoneRequired {
+		return respondent{}, domain.ErrPhoneRequired
+	}
+	if phone != "" && !validation.Phone(phone) {
+		return respondent{}, domain.ErrInvalidPhone
+	}
+
+	who := respondent{seed: respondentID, phone: phone, client: client}
+
+	switch sheet.DedupMode {
+	case domain.DedupPhone:
+		if phone == "" {
+			return respondent{}, domain.ErrPhoneRequired
+		}
+		who.key = "phone:" + phone
+	case domain.DedupToken:
+		if respondentID == "" {
+			return respondent{}, domain.ErrRespondentRequired
+		}
+		who.key = "token:" + respondentID
+	case domain.DedupFingerprint:
+		sum := sha256.Sum256([]byte(client.IP + "|" + client.UserAgent))
+		who.key = "fingerprint:" + hex.EncodeToString(sum[:])
+	default:
+		switch {
+		case respondentID != "":
+			who.key = "token:" + respondentID
+		case sheet.IsQuiz:
+			// Quiz scores and the leaderboard add up a respondent's ballots, which
+			// needs a key that stays the same across submissions.
+			return respondent{}, domain.ErrRespondentRequired
+		default:
+			who.key = primitive.NewObjectID().Hex()
+		}
+	}
+
+	return who, nil
 }
@@ -0,0 +1,223 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain/mocks"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSubmitVoteDisplayRule(t *testing.T) {
+	sheet := domain.Sheet{ID: primitive.NewObjectID(), Status: domain.SheetStatusPublished, DedupMode: domain.DedupToken}
+	gate := domain.Poll{ID: primitive.NewObjectID(), SheetID: sheet.ID, PollType: domain.PollTypeSingleChoice, Options: []string{"Yes", "No"}}
+	followUp := domain.Poll{
+		ID:       primitive.NewObjectID(),
+		SheetID:  sheet.ID,
+		PollType: domain.PollTypeSingleChoice,
+		Options:  []string{"a", "b"},
+		ShowIf:   &domain.DisplayRule{PollID: gate.ID, Options: []int{0}},
+	}
+
+	tests := []struct {
+		name     string
+		previous []domain.Ballot
+		wantErr  error
+	}{
+		{name: "rule poll not answered", wantErr: domain.ErrPollHidden},
+		{name: "rule poll answered with another option", previous: []domain.Ballot{{PollID: gate.ID, Options: []int{1}}}, wantErr: domain.ErrPollHidden},
+		{name: "rule poll answered with a trigger option", previous: []domain.Ballot{{PollID: gate.ID, Options: []int{0}}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockPollRepository := new(mocks.PollRepository)
+			mockSheetRepository := new(mocks.SheetRepository)
+			mockBallotRepository := new(mocks.BallotRepository)
+			tx := &transaction{}
+
+			mockPollRepository.On("GetByID", mock.Anything, followUp.ID.Hex()).Return(followUp, nil)
+			mockSheetRepository.On("GetByID", mock.Anything, sheet.ID.Hex()).Return(sheet, nil)
+			mockBallotRepository.On("GetByRespondent", mock.Anything, sheet.ID.Hex(), "token:respondent").Return(tc.previous, nil)
+			mockBallotRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.Ballot")).Return(nil).Maybe()
+			mockPollRepository.On("SubmitVote", mock.Anything, followUp.ID.Hex(), []int{1}).Return(nil).Maybe()
+
+			u := usecase.NewPollClientUsecase(mockPollRepository, mockSheetRepository, mockBallotRepository, newTransactionClient(tx), time.Second)
+			_, err := u.SubmitVote(context.Background(), domain.PollClientRequest{ID: followUp.ID.Hex(), Votes: []int{0, 1}, RespondentID: "respondent"})
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				mockBallotRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 1, tx.committed)
+			mockPollRepository.AssertCalled(t, "SubmitVote", mock.Anything, followUp.ID.Hex(), []int{1})
+		})
+	}
+}
+
+func TestSubmitVoteRespondent(t *testing.T) {
+	fingerprint := sha256.Sum256([]byte("203.0.113.7|test-agent"))
+
+	tests := []struct {
+		name         string
+		sheet        domain.Sheet
+		phone        string
+		respondentID string
+		wantErr      error
+		wantKey      string
+		wantUnique   bool
+	}{
+		{name: "phone", sheet: domain.Sheet{DedupMode: domain.DedupPhone}, phone: "+15551234567", wantKey: "phone:+15551234567", wantUnique: true},
+		{name: "phone missing", sheet: domain.Sheet{DedupMode: domain.DedupPhone}, wantErr: domain.ErrPhoneRequired},
+		{name: "phone invalid", sheet: domain.Sheet{DedupMode: domain.DedupNone}, phone: "not a phone", wantErr: domain.ErrInvalidPhone},
+		{name: "phone required by the sheet", sheet: domain.Sheet{DedupMode: domain.DedupNone, IsPhoneRequired: true}, wantErr: domain.ErrPhoneRequired},
+		{name: "token", sheet: domain.Sheet{DedupMode: domain.DedupToken}, respondentID: "respondent", wantKey: "token:respondent", wantUnique: true},
+		{name: "token missing", sheet: domain.Sheet{DedupMode: domain.DedupToken}, wantErr: domain.ErrRespondentRequired},
+		{name: "fingerprint", sheet: domain.Sheet{DedupMode: domain.DedupFingerprint}, wantKey: "fingerprint:" + hex.EncodeToString(fingerprint[:]), wantUnique: true},
+		{name: "none with token", sheet: domain.Sheet{DedupMode: domain.DedupNone}, respondentID: "respondent", wantKey: "token:respondent"},
+		{name: "none without token", sheet: domain.Sheet{DedupMode: domain.DedupNone}},
+		{name: "quiz without token", sheet: domain.Sheet{DedupMode: domain.DedupNone, IsQuiz: true}, wantErr: domain.ErrRespondentRequired},
+		{name: "quiz with token", sheet: domain.Sheet{DedupMode: domain.DedupNone, IsQuiz: true}, respondentID: "respondent", wantKey: "token:respondent"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sheet := tc.sheet
+			sheet.ID = primitive.NewObjectID()
+			sheet.Status = domain.SheetStatusPublished
+			poll := domain.Poll{ID: primitive.NewObjectID(), SheetID: sheet.ID, PollType: domain.PollTypeSingleChoice, Options: []string{"Yes", "No"}}
+
+			mockPollRepository := new(mocks.PollRepository)
+			mockSheetRepository := new(mocks.SheetRepository)
+			mockBallotRepository := new(mocks.BallotRepository)
+
+			var stored domain.Ballot
+			mockPollRepository.On("GetByID", mock.Anything, poll.ID.Hex()).Return(poll, nil)
+			mockSheetRepository.On("GetByID", mock.Anything, sheet.ID.Hex()).Return(sheet, nil)
+			mockBallotRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.Ballot")).Run(func(args mock.Arguments) {
+				stored = *args.Get(1).(*domain.Ballot)
+			}).Return(nil).Maybe()
+			mockPollRepository.On("SubmitVote", mock.Anything, poll.ID.Hex(), []int{0}).Return(nil).Maybe()
+			mockPollRepository.On("GetPollBySheetID", mock.Anything, sheet.ID.Hex(), domain.PaginationQuery{}).Return([]domain.Poll{poll}, int64(1), nil).Maybe()
+			mockBallotRepository.On("GetByRespondent", mock.Anything, sheet.ID.Hex(), mock.Anything).Return([]domain.Ballot{}, nil).Maybe()
+
+			u := usecase.NewPollClientUsecase(mockPollRepository, mockSheetRepository, mockBallotRepository, newTransactionClient(&transaction{}), time.Second)
+			_, err := u.SubmitVote(context.Background(), domain.PollClientRequest{
+				ID:           poll.ID.Hex(),
+				Votes:        []int{1, 0},
+				Phone:        tc.phone,
+				RespondentID: tc.respondentID,
+				Client:       domain.BallotClient{IP: "203.0.113.7", UserAgent: "test-agent"},
+			})
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				mockBallotRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			if tc.wantKey == "" {
+				// Without a way to recognise the respondent every ballot gets its own key.
+				assert.True(t, primitive.IsValidObjectID(stored.RespondentKey))
+			} else {
+				assert.Equal(t, tc.wantKey, stored.RespondentKey)
+			}
+			assert.Equal(t, tc.wantUnique, stored.Unique)
+		})
+	}
+}
+
+func TestSubmitSheet(t *testing.T) {
+	sheet := domain.Sheet{ID: primitive.NewObjectID(), Status: domain.SheetStatusPublished, DedupMode: domain.DedupToken}
+	first := domain.Poll{ID: primitive.NewObjectID(), SheetID: sheet.ID, PollType: domain.PollTypeSingleChoice, Options: []string{"Yes", "No"}}
+	second := domain.Poll{ID: primitive.NewObjectID(), SheetID: sheet.ID, PollType: domain.PollTypeMultiChoice, Options: []string{"a", "b", "c"}}
+
+	payload := domain.PollClientSheetRequest{
+		SheetID:      sheet.ID.Hex(),
+		RespondentID: "respondent",
+		Answers: []domain.PollClientAnswer{
+			{ID: first.ID.Hex(), Votes: []int{1, 0}},
+			{ID: second.ID.Hex(), Votes: []int{0, 1, 1}},
+		},
+	}
+
+	newUsecase := func(tx *transaction, secondErr error) (domain.PollClientUsecase, *mocks.PollRepository, *mocks.BallotRepository) {
+		mockPollRepository := new(mocks.PollRepository)
+		mockSheetRepository := new(mocks.SheetRepository)
+		mockBallotRepository := new(mocks.BallotRepository)
+
+		mockSheetRepository.On("GetByID", mock.Anything, sheet.ID.Hex()).Return(sheet, nil)
+		mockPollRepository.On("GetPollBySheetID", mock.Anything, sheet.ID.Hex(), domain.PaginationQuery{}).Return([]domain.Poll{first, second}, int64(2), nil)
+		mockBallotRepository.On("Create", mock.Anything, mock.MatchedBy(func(ballot *domain.Ballot) bool {
+			return ballot.PollID == first.ID
+		})).Return(nil)
+		mockBallotRepository.On("Create", mock.Anything, mock.MatchedBy(func(ballot *domain.Ballot) bool {
+			return ballot.PollID == second.ID
+		})).Return(secondErr)
+		mockPollRepository.On("SubmitVote", mock.Anything, first.ID.Hex(), []int{0}).Return(nil)
+		mockPollRepository.On("SubmitVote", mock.Anything, second.ID.Hex(), []int{1, 2}).Return(nil).Maybe()
+
+		return usecase.NewPollClientUsecase(mockPollRepository, mockSheetRepository, mockBallotRepository, newTransactionClient(tx), time.Second), mockPollRepository, mockBallotRepository
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tx := &transaction{}
+		u, mockPollRepository, mockBallotRepository := newUsecase(tx, nil)
+
+		score, err := u.SubmitSheet(context.Background(), payload)
+
+		assert.NoError(t, err)
+		assert.Nil(t, score)
+		assert.Equal(t, 1, tx.committed)
+		mockPollRepository.AssertExpectations(t)
+		mockBallotRepository.AssertExpectations(t)
+	})
+
+	t.Run("failing poll rolls back", func(t *testing.T) {
+		tx := &transaction{}
+		u, mockPollRepository, _ := newUsecase(tx, errors.New("write failed"))
+
+		_, err := u.SubmitSheet(context.Background(), payload)
+
+		// The first poll's ballot and counters were written in the aborted transaction.
+		assert.EqualError(t, err, "write failed")
+		assert.Equal(t, 0, tx.committed)
+		assert.Equal(t, 1, tx.aborted)
+		mockPollRepository.AssertNotCalled(t, "SubmitVote", mock.Anything, second.ID.Hex(), mock.Anything)
+	})
+
+	t.Run("duplicate ballot", func(t *testing.T) {
+		tx := &transaction{}
+		u, _, _ := newUsecase(tx, domain.ErrAlreadySubmitted)
+
+		_, err := u.SubmitSheet(context.Background(), payload)
+
+		assert.ErrorIs(t, err, domain.ErrAlreadySubmitted)
+		assert.Equal(t, 0, tx.committed)
+		assert.Equal(t, 1, tx.aborted)
+	})
+
+	t.Run("invalid answer writes nothing", func(t *testing.T) {
+		tx := &transaction{}
+		u, _, mockBallotRepository := newUsecase(tx, nil)
+
+		invalid := payload
+		invalid.Answers = []domain.PollClientAnswer{payload.Answers[0], {ID: second.ID.Hex(), Votes: []int{0, 0, 0}}}
+		_, err := u.SubmitSheet(context.Background(), invalid)
+
+		assert.ErrorIs(t, err, domain.ErrNoVotesSubmitted)
+		assert.Equal(t, 0, tx.committed+tx.aborted)
+		mockBallotRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
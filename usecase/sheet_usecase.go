@@ -6,14 +6,20 @@ import (
 	"time"
 
 	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
 )
 
 type sheetUseCase struct {
-	repository     domain.SheetRepository
-	userRepository domain.UserRepository
-	contextTimeout time.Duration
+	repository             domain.SheetRepository
+	userRepository         domain.UserRepository
+	pollRepository         domain.PollRepository
+	ballotRepository       domain.BallotRepository
+	notificationRepository domain.NotificationRepository
+	blobStore              domain.BlobStore
+	client                 mongo.Client
+	contextTimeout         time.Duration
 }
 
 func (s sheetUseCase) GetByUserID(c context.Context, userID string, pagination domain.PaginationQuery) ([]domain.SheetListItem, int64, error) {
@@ -47,6 +53,49 @@ func (s sheetUseCase) Create(c context.Context, sheet domain.Sheet) error {
 	return s.repository.Create(ctx, sheet)
 }
 
+// Duplicate stores sheet as a copy of the source sheet, together with fresh copies of the
+// source's polls, in one transaction.
+func (s sheetUseCase) Duplicate(c context.Context, sourceID string, sheet domain.Sheet) ([]domain.Poll, error) {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	polls, _, err := s.pollRepository.GetPollBySheetID(ctx, sourceID, domain.PaginationQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	copies := domain.DuplicatePolls(polls, sheet.ID, sheet.CreatedAt)
+
+	if err = s.insertWithPolls(ctx, sheet, copies); err != nil {
+		return nil, err
+	}
+
+	return copies, nil
+}
+
+// CreateWithPolls stores a new sheet and its polls in one transaction, so either all of
+// them are created or none.
+func (s sheetUseCase) CreateWithPolls(c context.Context, sheet domain.Sheet, polls []domain.Poll) error {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	return s.insertWithPolls(ctx, sheet, polls)
+}
+
+func (s sheetUseCase) insertWithPolls(ctx context.Context, sheet domain.Sheet, polls []domain.Poll) error {
+	return withTransaction(ctx, s.client, func(txCtx context.Context) error {
+		if err := s.repository.Create(txCtx, sheet); err != nil {
+			return err
+		}
+		for i := range polls {
+			if err := s.pollRepository.Create(txCtx, &polls[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (s sheetUseCase) GetAll(c context.Context, pagination domain.PaginationQuery) ([]domain.SheetListItem, int64, error) {
 	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
 	defer cancel()
@@ -64,11 +113,199 @@ func (s sheetUseCase) GetAll(c context.Context, pagination domain.PaginationQuer
 	return items, total, nil
 }
 
-func (s sheetUseCase) Delete(c context.Context, id string) error {
+// Delete removes the sheet with its polls, ballots and pending approval notifications
+// in one transaction.
+func (s sheetUseCase) Delete(c context.Context, id string) (domain.SheetDeleteResult, error) {
 	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
 	defer cancel()
 
-	return s.repository.Delete(ctx, id)
+	var result domain.SheetDeleteResult
+	err := withTransaction(ctx, s.client, func(txCtx context.Context) error {
+		result = domain.SheetDeleteResult{}
+
+		var err error
+		if result.Ballots, err = s.ballotRepository.DeleteBySheetID(txCtx, id); err != nil {
+			return err
+		}
+		if result.Polls, err = s.pollRepository.DeleteBySheetID(txCtx, id); err != nil {
+			return err
+		}
+		if result.Notifications, err = s.notificationRepository.DeletePendingBySheetID(txCtx, id); err != nil {
+			return err
+		}
+		return s.repository.Delete(txCtx, id)
+	})
+	if err != nil {
+		return domain.SheetDeleteResult{}, err
+	}
+
+	return result, nil
+}
+
+// Trash moves the sheet and its polls to the trash in one transaction. Ballots and
+// notifications stay untouched so that a restore brings the sheet back as it was.
+func (s sheetUseCase) Trash(c context.Context, id string, deletedBy primitive.ObjectID, deletedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	return withTransaction(ctx, s.client, func(txCtx context.Context) error {
+		if _, err := s.pollRepository.SoftDeleteBySheetID(txCtx, id, deletedBy, deletedAt); err != nil {
+			return err
+		}
+		return s.repository.SoftDelete(txCtx, id, deletedBy, deletedAt)
+	})
+}
+
+// Restore takes the sheet out of the trash together with the polls that were trashed
+// with it. Polls deleted on their own before the sheet stay in the trash.
+func (s sheetUseCase) Restore(c context.Context, id string, restoredAt time.Time) error {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	sheet, err := s.repository.GetDeletedByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return withTransaction(ctx, s.client, func(txCtx context.Context) error {
+		if sheet.DeletedAt != nil {
+			if _, err := s.pollRepository.RestoreBySheetID(txCtx, id, *sheet.DeletedAt, restoredAt); err != nil {
+				return err
+			}
+		}
+		return s.repository.Restore(txCtx, id, restoredAt)
+	})
+}
+
+func (s sheetUseCase) GetTrash(c context.Context, userID string, pagination domain.PaginationQuery) ([]domain.SheetListItem, int64, error) {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	sheets, total, err := s.repository.GetDeleted(ctx, userID, pagination)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items, err := s.buildSheetListItems(ctx, sheets)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
+
+func (s sheetUseCase) GetTrashedByID(c context.Context, id string) (domain.Sheet, error) {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	return s.repository.GetDeletedByID(ctx, id)
+}
+
+// PurgeTrash permanently deletes sheets and polls that were trashed at or before cutoff,
+// along with their ballots and option media. Each sheet is removed through the Delete
+// cascade.
+func (s sheetUseCase) PurgeTrash(c context.Context, cutoff time.Time) (domain.TrashPurgeResult, error) {
+	var result domain.TrashPurgeResult
+
+	sheets, err := s.listTrashedSheets(c, cutoff)
+	if err != nil {
+		return result, err
+	}
+
+	for _, sheet := range sheets {
+		polls, err := s.listSheetPolls(c, sheet.ID.Hex())
+		if err != nil {
+			return result, err
+		}
+
+		deleted, err := s.Delete(c, sheet.ID.Hex())
+		if err != nil {
+			return result, err
+		}
+		s.deleteOptionMedia(c, polls)
+
+		result.Sheets++
+		result.Polls += deleted.Polls
+		result.Ballots += deleted.Ballots
+	}
+
+	polls, err := s.listTrashedPolls(c, cutoff)
+	if err != nil {
+		return result, err
+	}
+
+	for _, poll := range polls {
+		ballots, err := s.purgePoll(c, poll.ID.Hex())
+		if err != nil {
+			return result, err
+		}
+		s.deleteOptionMedia(c, []domain.Poll{poll})
+
+		result.Polls++
+		result.Ballots += ballots
+	}
+
+	return result, nil
+}
+
+func (s sheetUseCase) listTrashedSheets(c context.Context, cutoff time.Time) ([]domain.Sheet, error) {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	return s.repository.GetDeletedBefore(ctx, cutoff)
+}
+
+func (s sheetUseCase) listTrashedPolls(c context.Context, cutoff time.Time) ([]domain.Poll, error) {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	return s.pollRepository.GetDeletedBefore(ctx, cutoff)
+}
+
+// listSheetPolls returns every poll of the sheet, trashed or not.
+func (s sheetUseCase) listSheetPolls(c context.Context, sheetID string) ([]domain.Poll, error) {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	live, _, err := s.pollRepository.GetPollBySheetID(ctx, sheetID, domain.PaginationQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	trashed, err := s.pollRepository.GetDeletedBySheetID(ctx, sheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(live, trashed...), nil
+}
+
+// deleteOptionMedia removes the stored images of purged polls. It runs once the purge
+// has committed, so a failure only leaves an orphaned blob behind.
+func (s sheetUseCase) deleteOptionMedia(c context.Context, polls []domain.Poll) {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	for _, poll := range polls {
+		for _, media := range poll.OptionMedia {
+			_ = s.blobStore.Delete(ctx, media.Key)
+		}
+	}
+}
+
+func (s sheetUseCase) purgePoll(c context.Context, pollID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	var ballots int64
+	err := withTransaction(ctx, s.client, func(txCtx context.Context) error {
+		var err error
+		if ballots, err = s.ballotRepository.DeleteByPollID(txCtx, pollID); err != nil {
+			return err
+		}
+		return s.pollRepository.Delete(txCtx, pollID)
+	})
+	return ballots, err
 }
 
 func (s sheetUseCase) UpdateStatus(c context.Context, id string, status domain.SheetStatus, approvedBy primitive.ObjectID, approvedAt time.Time) error {
@@ -78,6 +315,20 @@ func (s sheetUseCase) UpdateStatus(c context.Context, id string, status domain.S
 	return s.repository.UpdateStatus(ctx, id, status, approvedBy, approvedAt)
 }
 
+func (s sheetUseCase) Close(c context.Context, id string, closedBy primitive.ObjectID, reason domain.SheetCloseReason, closedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	return s.repository.Close(ctx, id, closedBy, reason, closedAt)
+}
+
+func (s sheetUseCase) CloseExpired(c context.Context, now time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	return s.repository.CloseExpired(ctx, now)
+}
+
 func (s sheetUseCase) buildSheetListItems(ctx context.Context, sheets []domain.Sheet) ([]domain.SheetListItem, error) {
 	if len(sheets) == 0 {
 		return []domain.SheetListItem{}, nil
@@ -95,7 +346,7 @@ func (s sheetUseCase) buildSheetListItems(ctx context.Context, sheets []domain.S
 	for ownerID := range ownerIDs {
 		user, err := s.userRepository.GetByID(ctx, ownerID)
 		if err != nil {
-			if errors.Is(err, mongo.ErrNoDocuments) {
+			if errors.Is(err, mongodriver.ErrNoDocuments) {
 				continue
 			}
 			return nil, err
@@ -111,6 +362,7 @@ func (s sheetUseCase) buildSheetListItems(ctx context.Context, sheets []domain.S
 			Venue:     sheet.Venue,
 			Status:    sheet.Status,
 			UpdatedAt: sheet.UpdatedAt,
+			DeletedAt: sheet.DeletedAt,
 		}
 
 		if !sheet.UserID.IsZero() {
@@ -129,10 +381,45 @@ func (s sheetUseCase) buildSheetListItems(ctx context.Context, sheets []domain.S
 	return items, nil
 }
 
-func NewSheetUseCase(repo domain.SheetRepository, userRepo domain.UserRepository, timeout time.Duration) domain.SheetUseCase {
+func (s sheetUseCase) Update(c context.Context, id string, req domain.SheetUpdateRequest, now time.Time) (domain.Sheet, error) {
+	ctx, cancel := context.WithTimeout(c, s.contextTimeout)
+	defer cancel()
+
+	sheet, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return domain.Sheet{}, err
+	}
+
+	polls, _, err := s.pollRepository.GetPollBySheetID(ctx, id, domain.PaginationQuery{})
+	if err != nil {
+		return domain.Sheet{}, err
+	}
+
+	responses, err := s.ballotRepository.CountByPollIDs(ctx, pollIDs(polls))
+	if err != nil {
+		return domain.Sheet{}, err
+	}
+
+	if err = sheet.ApplyUpdate(req, responses > 0, now); err != nil {
+		return domain.Sheet{}, err
+	}
+
+	if err = s.repository.Update(ctx, sheet); err != nil {
+		return domain.Sheet{}, err
+	}
+
+	return sheet, nil
+}
+
+func NewSheetUseCase(repo domain.SheetRepository, userRepo domain.UserRepository, pollRepo domain.PollRepository, ballotRepo domain.BallotRepository, notificationRepo domain.NotificationRepository, blobStore domain.BlobStore, client mongo.Client, timeout time.Duration) domain.SheetUseCase {
 	return &sheetUseCase{
-		repository:     repo,
-		userRepository: userRepo,
-		contextTimeout: timeout,
+		repository:             repo,
+		userRepository:         userRepo,
+		pollRepository:         pollRepo,
+		ballotRepository:       ballotRepo,
+		notificationRepository: notificationRepo,
+		blobStore:              blobStore,
+		client:                 client,
+		contextTimeout:         timeout,
 	}
 }
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type templateUsecase struct {
+	repository     domain.TemplateRepository
+	contextTimeout time.Duration
+}
+
+func NewTemplateUsecase(repository domain.TemplateRepository, timeout time.Duration) domain.TemplateUsecase {
+	return &templateUsecase{
+		repository:     repository,
+		contextTimeout: timeout,
+	}
+}
+
+func (t templateUsecase) Publish(c context.Context, req domain.TemplateRequest, createdBy primitive.ObjectID, now time.Time) (domain.Template, error) {
+	ctx, cancel := context.WithTimeout(c, t.contextTimeout)
+	defer cancel()
+
+	template := domain.Template{
+		ID:        primitive.NewObjectID(),
+		Version:   1,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := template.Apply(req); err != nil {
+		return domain.Template{}, err
+	}
+
+	if err := t.repository.Create(ctx, &template); err != nil {
+		return domain.Template{}, err
+	}
+
+	return template, nil
+}
+
+func (t templateUsecase) Update(c context.Context, id string, req domain.TemplateRequest, now time.Time) (domain.Template, error) {
+	ctx, cancel := context.WithTimeout(c, t.contextTimeout)
+	defer cancel()
+
+	template, err := t.get(ctx, id)
+	if err != nil {
+		return domain.Template{}, err
+	}
+
+	if err = template.Apply(req); err != nil {
+		return domain.Template{}, err
+	}
+
+	previousVersion := template.Version
+	template.Version++
+	template.UpdatedAt = now
+
+	if err = t.repository.Update(ctx, &template, previousVersion); err != nil {
+		return domain.Template{}, err
+	}
+
+	return template, nil
+}
+
+func (t templateUsecase) GetByID(c context.Context, id string) (domain.Template, error) {
+	ctx, cancel := context.WithTimeout(c, t.contextTimeout)
+	defer cancel()
+
+	return t.get(ctx, id)
+}
+
+func (t templateUsecase) List(c context.Context, filter domain.TemplateFilter, pagination domain.PaginationQuery) ([]domain.Template, int64, error) {
+	ctx, cancel := context.WithTimeout(c, t.contextTimeout)
+	defer cancel()
+
+	return t.repository.List(ctx, filter, pagination)
+}
+
+func (t templateUsecase) Delete(c context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(c, t.contextTimeout)
+	defer cancel()
+
+	return t.repository.Delete(ctx, id)
+}
+
+func (t templateUsecase) get(ctx context.Context, id string) (domain.Template, error) {
+	template, err := t.repository.GetByID(ctx, id)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return domain.Template{}, domain.ErrTemplateNotFound
+	}
+	return template, err
+}
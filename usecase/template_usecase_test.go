@@ -0,0 +1,75 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/domain/mocks"
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestUpdateTemplate(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	stored := domain.Template{ID: primitive.NewObjectID(), Name: "Standup", Version: 3}
+	req := domain.TemplateRequest{Name: "Daily standup"}
+
+	t.Run("success", func(t *testing.T) {
+		mockTemplateRepository := new(mocks.TemplateRepository)
+		mockTemplateRepository.On("GetByID", mock.Anything, stored.ID.Hex()).Return(stored, nil).Once()
+		mockTemplateRepository.On("Update", mock.Anything, mock.MatchedBy(func(template *domain.Template) bool {
+			return template.Version == 4 && template.Name == "Daily standup"
+		}), 3).Return(nil).Once()
+
+		u := usecase.NewTemplateUsecase(mockTemplateRepository, time.Second*2)
+
+		template, err := u.Update(context.Background(), stored.ID.Hex(), req, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, template.Version)
+		assert.Equal(t, now, template.UpdatedAt)
+		mockTemplateRepository.AssertExpectations(t)
+	})
+
+	t.Run("concurrent update", func(t *testing.T) {
+		mockTemplateRepository := new(mocks.TemplateRepository)
+		mockTemplateRepository.On("GetByID", mock.Anything, stored.ID.Hex()).Return(stored, nil).Once()
+		mockTemplateRepository.On("Update", mock.Anything, mock.Anything, 3).Return(domain.ErrTemplateConflict).Once()
+
+		u := usecase.NewTemplateUsecase(mockTemplateRepository, time.Second*2)
+
+		_, err := u.Update(context.Background(), stored.ID.Hex(), req, now)
+
+		assert.ErrorIs(t, err, domain.ErrTemplateConflict)
+		mockTemplateRepository.AssertExpectations(t)
+	})
+
+	t.Run("missing template", func(t *testing.T) {
+		mockTemplateRepository := new(mocks.TemplateRepository)
+		mockTemplateRepository.On("GetByID", mock.Anything, stored.ID.Hex()).Return(domain.Template{}, mongodriver.ErrNoDocuments).Once()
+
+		u := usecase.NewTemplateUsecase(mockTemplateRepository, time.Second*2)
+
+		_, err := u.Update(context.Background(), stored.ID.Hex(), req, now)
+
+		assert.ErrorIs(t, err, domain.ErrTemplateNotFound)
+		mockTemplateRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		mockTemplateRepository := new(mocks.TemplateRepository)
+		mockTemplateRepository.On("GetByID", mock.Anything, stored.ID.Hex()).Return(stored, nil).Once()
+
+		u := usecase.NewTemplateUsecase(mockTemplateRepository, time.Second*2)
+
+		_, err := u.Update(context.Background(), stored.ID.Hex(), domain.TemplateRequest{}, now)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidTemplate)
+		mockTemplateRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+// withTransaction runs fn inside a MongoDB transaction; repository calls made with the
+// supplied context are committed together or not at all.
+func withTransaction(ctx context.Context, client mongo.Client, fn func(context.Context) error) error {
+	return client.UseSession(ctx, func(sessionCtx mongodriver.SessionContext) error {
+		_, err := sessionCtx.WithTransaction(sessionCtx, func(txCtx mongodriver.SessionContext) (interface{}, error) {
+			return nil, fn(txCtx)
+		})
+		return err
+	})
+}
@@ -0,0 +1,42 @@
+package usecase_test
+
+import (
+	"context"
+
+	mongomocks "github.com/amitshekhariitbhu/go-backend-clean-architecture/mongo/mocks"
+	"github.com/stretchr/testify/mock"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// transaction records the outcome of the transactions run through a client built by
+// newTransactionClient.
+type transaction struct {
+	committed int
+	aborted   int
+}
+
+// session runs transaction callbacks in place of a MongoDB session.
+type session struct {
+	context.Context
+	mongodriver.Session
+	tx *transaction
+}
+
+func (s session) WithTransaction(_ context.Context, fn func(mongodriver.SessionContext) (interface{}, error), _ ...*options.TransactionOptions) (interface{}, error) {
+	result, err := fn(s)
+	if err != nil {
+		s.tx.aborted++
+		return nil, err
+	}
+	s.tx.committed++
+	return result, nil
+}
+
+func newTransactionClient(tx *transaction) *mongomocks.Client {
+	client := new(mongomocks.Client)
+	client.On("UseSession", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(mongodriver.SessionContext) error) error {
+		return fn(session{Context: ctx, tx: tx})
+	})
+	return client
+}